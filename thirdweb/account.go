@@ -0,0 +1,273 @@
+package thirdweb
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// An account signs the transactions sent by the SDK. By default the SDK uses the private key from
+// the SDK options, but you can implement this interface to sign with a hardware wallet, a remote
+// signer, or a mock in your tests.
+type Account interface {
+	Address() common.Address
+	Sign(tx *types.Transaction, chainId *big.Int) (*types.Transaction, error)
+}
+
+// A broadcaster sends signed transactions to the network. By default the SDK sends them through its
+// RPC provider, but you can implement this interface to use a private relay, or a mock in your tests.
+type Broadcaster interface {
+	Send(ctx context.Context, tx *types.Transaction) (common.Hash, error)
+}
+
+// Implemented by the broadcasters that pay for the gas themselves, like relayers, so the SDK wallet
+// doesn't need to hold any funds
+type gasSponsor interface {
+	sponsorsGas()
+}
+
+type privateKeyAccount struct {
+	privateKey *ecdsa.PrivateKey
+	address    common.Address
+}
+
+func newPrivateKeyAccount(privateKey *ecdsa.PrivateKey, address common.Address) *privateKeyAccount {
+	return &privateKeyAccount{
+		privateKey: privateKey,
+		address:    address,
+	}
+}
+
+func (account *privateKeyAccount) Address() common.Address {
+	return account.address
+}
+
+func (account *privateKeyAccount) Sign(tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	signer, err := getChainSigner(chainId)
+	if err != nil {
+		return nil, err
+	}
+
+	return signer.SignTx(tx, account.privateKey)
+}
+
+// An account backed by a private key loaded from an encrypted keystore file
+type LocalSigner struct {
+	*privateKeyAccount
+}
+
+// Load an account from an encrypted JSON keystore file, like the ones created by geth account new
+// or Clef, so the private key never has to be stored in plain text.
+//
+// path: the path of the keystore file
+//
+// password: the password the keystore was encrypted with
+//
+// returns: the decrypted account, which can be passed to UpdateAccount
+//
+// Example
+//
+//	signer, err := thirdweb.LoadKeystoreFile("keystore/UTC--2022-...", os.Getenv("KEYSTORE_PASSWORD"))
+//	sdk.UpdateAccount(signer)
+func LoadKeystoreFile(path string, password string) (*LocalSigner, error) {
+	keyJSON, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalSigner{newPrivateKeyAccount(key.PrivateKey, key.Address)}, nil
+}
+
+type providerBroadcaster struct {
+	handler *ProviderHandler
+}
+
+func (broadcaster *providerBroadcaster) Send(ctx context.Context, tx *types.Transaction) (common.Hash, error) {
+	if err := broadcaster.handler.GetProvider().SendTransaction(ctx, tx); err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), nil
+}
+
+// The backend passed to the contract bindings. It resolves the provider on every call, so a
+// reconnected provider is picked up, and hands signed transactions off to the broadcaster.
+type handlerBackend struct {
+	handler *ProviderHandler
+}
+
+func (backend *handlerBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) (result []byte, err error) {
+	observed := backend.handler.observeRpcCall("eth_getCode")
+	defer func() { observed(err) }()
+	return backend.handler.GetProvider().CodeAt(ctx, contract, blockNumber)
+}
+
+func (backend *handlerBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) (result []byte, err error) {
+	observed := backend.handler.observeRpcCall("eth_call")
+	ctx, span := backend.handler.startSpan(ctx, "eth_call")
+	defer func() {
+		endSpan(span, err)
+		observed(err)
+	}()
+	if call.To != nil {
+		span.SetAttribute("eth.contract_address", call.To.Hex())
+	}
+
+	return backend.handler.GetProvider().CallContract(ctx, call, blockNumber)
+}
+
+func (backend *handlerBackend) HeaderByNumber(ctx context.Context, number *big.Int) (header *types.Header, err error) {
+	observed := backend.handler.observeRpcCall("eth_getBlockByNumber")
+	defer func() { observed(err) }()
+	return backend.handler.GetProvider().HeaderByNumber(ctx, number)
+}
+
+func (backend *handlerBackend) PendingCodeAt(ctx context.Context, account common.Address) (result []byte, err error) {
+	observed := backend.handler.observeRpcCall("eth_getCode")
+	defer func() { observed(err) }()
+	return backend.handler.GetProvider().PendingCodeAt(ctx, account)
+}
+
+func (backend *handlerBackend) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
+	observed := backend.handler.observeRpcCall("eth_getTransactionCount")
+	defer func() { observed(err) }()
+	return backend.handler.GetProvider().PendingNonceAt(ctx, account)
+}
+
+func (backend *handlerBackend) SuggestGasPrice(ctx context.Context) (gasPrice *big.Int, err error) {
+	observed := backend.handler.observeRpcCall("eth_gasPrice")
+	defer func() { observed(err) }()
+	return backend.handler.GetProvider().SuggestGasPrice(ctx)
+}
+
+func (backend *handlerBackend) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error) {
+	observed := backend.handler.observeRpcCall("eth_maxPriorityFeePerGas")
+	defer func() { observed(err) }()
+	return backend.handler.GetProvider().SuggestGasTipCap(ctx)
+}
+
+func (backend *handlerBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
+	observed := backend.handler.observeRpcCall("eth_estimateGas")
+	defer func() { observed(err) }()
+
+	// Nodes reject estimates from a wallet that can't pay the fees of the call, so when someone else
+	// pays for the gas we leave the fees out and only estimate the gas used
+	if _, ok := backend.handler.getBroadcaster().(gasSponsor); ok {
+		call.GasPrice = nil
+		call.GasFeeCap = nil
+		call.GasTipCap = nil
+	}
+	return backend.handler.GetProvider().EstimateGas(ctx, call)
+}
+
+func (backend *handlerBackend) SendTransaction(ctx context.Context, tx *types.Transaction) (err error) {
+	if backend.handler.simulationMode {
+		return backend.simulateTransaction(ctx, tx)
+	}
+
+	observed := backend.handler.observeRpcCall("eth_sendRawTransaction")
+	ctx, span := backend.handler.startSpan(ctx, "eth_sendRawTransaction")
+	defer func() {
+		endSpan(span, err)
+		observed(err)
+	}()
+	if tx.To() != nil {
+		span.SetAttribute("eth.contract_address", tx.To().Hex())
+	}
+	span.SetAttribute("eth.tx_hash", tx.Hash().Hex())
+
+	hash, err := backend.handler.getBroadcaster().Send(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	// Relayers submit their own transaction, so remember which hash to wait on instead
+	if hash != tx.Hash() {
+		backend.handler.relayedTxHashes.Store(tx.Hash(), hash)
+	}
+
+	backend.handler.events.emit(EventTransactionSent, map[string]interface{}{
+		"hash": hash.String(),
+	})
+	return nil
+}
+
+// What a write call made with SmartContract.Call returns in simulation mode
+type SimulationResult struct {
+	// The transaction that would have been sent
+	Transaction *types.Transaction
+	// The return values of the called function, decoded with the contract ABI
+	Outputs []interface{}
+}
+
+// A transaction run with eth_call in simulation mode, along with what the call returned
+type simulatedTx struct {
+	tx     *types.Transaction
+	result []byte
+}
+
+// Decodes what the simulated call returned with the outputs of the called method
+func newSimulationResult(method abi.Method, simulated *simulatedTx) (*SimulationResult, error) {
+	outputs, err := method.Outputs.Unpack(simulated.result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SimulationResult{
+		Transaction: simulated.tx,
+		Outputs:     outputs,
+	}, nil
+}
+
+// Runs the transaction with eth_call so it has no effect on-chain, returning the revert error if
+// it would have failed
+func (backend *handlerBackend) simulateTransaction(ctx context.Context, tx *types.Transaction) error {
+	call := ethereum.CallMsg{
+		From:  backend.handler.GetSignerAddress(),
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+	// Nodes reject calls that set both the legacy gas price and the EIP-1559 fees
+	if tx.Type() == types.LegacyTxType {
+		call.GasPrice = tx.GasPrice()
+	} else {
+		call.GasFeeCap = tx.GasFeeCap()
+		call.GasTipCap = tx.GasTipCap()
+	}
+
+	result, err := backend.CallContract(ctx, call, nil)
+	if err != nil {
+		return err
+	}
+
+	backend.handler.simulatedTxs.Store(tx.Hash(), &simulatedTx{tx, result})
+	backend.handler.events.emit(EventTransactionSimulated, map[string]interface{}{
+		"hash":   tx.Hash().String(),
+		"result": hexutil.Encode(result),
+	})
+	return nil
+}
+
+func (backend *handlerBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) (logs []types.Log, err error) {
+	observed := backend.handler.observeRpcCall("eth_getLogs")
+	defer func() { observed(err) }()
+	return backend.handler.GetProvider().FilterLogs(ctx, query)
+}
+
+func (backend *handlerBackend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return backend.handler.GetProvider().SubscribeFilterLogs(ctx, query, ch)
+}
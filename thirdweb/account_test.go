@@ -0,0 +1,366 @@
+package thirdweb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockBroadcaster struct {
+	sent []*types.Transaction
+}
+
+func (broadcaster *mockBroadcaster) Send(ctx context.Context, tx *types.Transaction) (common.Hash, error) {
+	broadcaster.sent = append(broadcaster.sent, tx)
+	return tx.Hash(), nil
+}
+
+func TestPrivateKeyAccountSign(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	account := newPrivateKeyAccount(key, address)
+
+	chainId := big.NewInt(1337)
+	tx := types.NewTx(&types.DynamicFeeTx{ChainID: chainId, Gas: 21000})
+
+	signed, err := account.Sign(tx, chainId)
+	assert.Nil(t, err)
+
+	sender, err := types.Sender(types.LatestSignerForChainID(chainId), signed)
+	assert.Nil(t, err)
+	assert.Equal(t, address, sender)
+}
+
+func TestBackendUsesBroadcaster(t *testing.T) {
+	handler, err := NewProviderHandler(nil, "")
+	assert.Nil(t, err)
+
+	broadcaster := &mockBroadcaster{}
+	handler.UpdateBroadcaster(broadcaster)
+
+	tx := types.NewTx(&types.LegacyTx{Gas: 21000})
+	err = handler.getBackend().SendTransaction(context.Background(), tx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(broadcaster.sent))
+	assert.Equal(t, tx.Hash(), broadcaster.sent[0].Hash())
+}
+
+type mockRelayer struct {
+	relayedHash common.Hash
+}
+
+func (relayer *mockRelayer) Send(ctx context.Context, tx *types.Transaction) (common.Hash, error) {
+	return relayer.relayedHash, nil
+}
+
+func TestBackendTracksRelayedHash(t *testing.T) {
+	handler, err := NewProviderHandler(nil, "")
+	assert.Nil(t, err)
+
+	relayedHash := common.HexToHash("0x1234")
+	handler.UpdateBroadcaster(&mockRelayer{relayedHash})
+
+	handler.events = newEventEmitter()
+	sent := []Event{}
+	handler.events.On(EventTransactionSent, func(event Event) {
+		sent = append(sent, event)
+	})
+
+	tx := types.NewTx(&types.LegacyTx{Gas: 21000})
+	err = handler.getBackend().SendTransaction(context.Background(), tx)
+	assert.Nil(t, err)
+	assert.Equal(t, relayedHash, handler.getSentTxHash(tx.Hash()))
+	assert.Equal(t, relayedHash, handler.clone().getSentTxHash(tx.Hash()))
+
+	// The event is emitted once the transaction is sent, with the hash that was actually sent
+	assert.Equal(t, 1, len(sent))
+	assert.Equal(t, relayedHash.String(), sent[0].Data["hash"])
+}
+
+func TestBackendEstimatesSponsoredGasWithoutFees(t *testing.T) {
+	// Records the call of every eth_estimateGas request
+	calls := []map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Id     json.RawMessage          `json:"id"`
+			Method string                   `json:"method"`
+			Params []map[string]interface{} `json:"params"`
+		}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "eth_estimateGas", req.Method)
+		calls = append(calls, req.Params[0])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":"0x5208"}`, req.Id)))
+	}))
+	defer server.Close()
+
+	rpcClient, err := rpc.DialHTTP(server.URL)
+	assert.Nil(t, err)
+	handler, err := NewProviderHandler(ethclient.NewClient(rpcClient), "")
+	assert.Nil(t, err)
+
+	to := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	call := ethereum.CallMsg{
+		From:     common.HexToAddress("0x0000000000000000000000000000000000000002"),
+		To:       &to,
+		GasPrice: big.NewInt(100),
+	}
+
+	_, err = handler.getBackend().EstimateGas(context.Background(), call)
+	assert.Nil(t, err)
+	assert.Equal(t, "0x64", calls[0]["gasPrice"])
+
+	// A relayer pays for the gas, so the SDK wallet doesn't need to afford the fees
+	handler.UpdateBroadcaster(newBiconomyBroadcaster(handler, "", "", defaultBiconomyDeadlineSeconds, http.DefaultClient))
+	_, err = handler.getBackend().EstimateGas(context.Background(), call)
+	assert.Nil(t, err)
+	assert.NotContains(t, calls[1], "gasPrice")
+}
+
+func TestAwaitTxForgetsRelayedHash(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+	relayedTx, err := types.SignTx(types.NewTx(&types.LegacyTx{Nonce: 1, Gas: 21000, GasPrice: big.NewInt(1)}), types.HomesteadSigner{}, key)
+	assert.Nil(t, err)
+
+	// Answers with the relayed transaction, already mined in block 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Id     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var result interface{}
+		switch req.Method {
+		case "eth_getTransactionByHash":
+			var fields map[string]interface{}
+			encoded, err := json.Marshal(relayedTx)
+			assert.Nil(t, err)
+			assert.Nil(t, json.Unmarshal(encoded, &fields))
+			fields["blockNumber"] = "0x1"
+			fields["blockHash"] = common.HexToHash("0x1")
+			result = fields
+		case "eth_getTransactionReceipt":
+			result = &types.Receipt{
+				Status:      types.ReceiptStatusSuccessful,
+				TxHash:      relayedTx.Hash(),
+				BlockHash:   common.HexToHash("0x1"),
+				BlockNumber: big.NewInt(1),
+				Logs:        []*types.Log{},
+			}
+		default:
+			t.Fatalf("Unexpected RPC method %s", req.Method)
+		}
+
+		encoded, err := json.Marshal(result)
+		assert.Nil(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":%s}`, req.Id, encoded)))
+	}))
+	defer server.Close()
+
+	rpcClient, err := rpc.DialHTTP(server.URL)
+	assert.Nil(t, err)
+	handler, err := NewProviderHandler(ethclient.NewClient(rpcClient), "")
+	assert.Nil(t, err)
+	handler.UpdateBroadcaster(&mockRelayer{relayedTx.Hash()})
+
+	tx := types.NewTx(&types.LegacyTx{Gas: 21000})
+	err = handler.getBackend().SendTransaction(context.Background(), tx)
+	assert.Nil(t, err)
+
+	helper := &contractHelper{ProviderHandler: handler.clone()}
+	minedTx, err := helper.AwaitTx(context.Background(), tx.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, relayedTx.Hash(), minedTx.Hash())
+
+	_, ok := handler.relayedTxHashes.Load(tx.Hash())
+	assert.False(t, ok)
+}
+
+func TestLoadKeystoreFile(t *testing.T) {
+	dir := t.TempDir()
+	account, err := keystore.StoreKey(dir, "password", keystore.LightScryptN, keystore.LightScryptP)
+	assert.Nil(t, err)
+
+	signer, err := LoadKeystoreFile(account.URL.Path, "password")
+	assert.Nil(t, err)
+	assert.Equal(t, account.Address, signer.Address())
+
+	_, err = LoadKeystoreFile(account.URL.Path, "wrong password")
+	assert.NotNil(t, err)
+}
+
+func TestAwaitTxReturnsSimulatedTx(t *testing.T) {
+	handler, err := NewProviderHandler(nil, "")
+	assert.Nil(t, err)
+
+	tx := types.NewTx(&types.LegacyTx{Gas: 21000})
+	handler.simulatedTxs.Store(tx.Hash(), &simulatedTx{tx: tx})
+
+	helper := &contractHelper{ProviderHandler: handler.clone()}
+	minedTx, err := helper.AwaitTx(context.Background(), tx.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, tx.Hash(), minedTx.Hash())
+
+	_, ok := handler.simulatedTxs.Load(tx.Hash())
+	assert.False(t, ok)
+}
+
+func TestSimulationResultDecodesOutputs(t *testing.T) {
+	parsedAbi, err := abi.JSON(strings.NewReader(`[{"type":"function","name":"mintTo","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"}],"outputs":[{"name":"tokenId","type":"uint256"}]}]`))
+	assert.Nil(t, err)
+	method := parsedAbi.Methods["mintTo"]
+
+	returnData, err := method.Outputs.Pack(big.NewInt(42))
+	assert.Nil(t, err)
+
+	tx := types.NewTx(&types.LegacyTx{Gas: 21000})
+	result, err := newSimulationResult(method, &simulatedTx{tx, returnData})
+	assert.Nil(t, err)
+	assert.Equal(t, tx.Hash(), result.Transaction.Hash())
+	assert.Equal(t, []interface{}{big.NewInt(42)}, result.Outputs)
+}
+
+func TestChainSignerSendsLegacyTxsOnPolygonZkEVM(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+
+	chainId := big.NewInt(POLYGON_ZKEVM)
+	signer, err := getChainSigner(chainId)
+	assert.Nil(t, err)
+
+	tx := types.NewTx(&types.DynamicFeeTx{ChainID: chainId, Gas: 21000, GasFeeCap: big.NewInt(100), GasTipCap: big.NewInt(1)})
+	signed, err := signer.SignTx(tx, key)
+	assert.Nil(t, err)
+	assert.Equal(t, uint8(types.LegacyTxType), signed.Type())
+	assert.Equal(t, big.NewInt(100), signed.GasPrice())
+
+	sender, err := types.Sender(types.NewEIP155Signer(chainId), signed)
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), sender)
+
+	starknetChainId, _ := new(big.Int).SetString("534e5f4d41494e", 16)
+	signer, err = getChainSigner(starknetChainId)
+	assert.Nil(t, err)
+	_, err = signer.SignTx(tx, key)
+	assert.NotNil(t, err)
+}
+
+func TestSendParallelFillsSkippedNonces(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+
+	// The node starts at nonce 5 and only counts the pending nonces up to the first gap
+	var mu sync.Mutex
+	pendingNonce := uint64(5)
+	var filler *types.Transaction
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Id     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		var result interface{}
+		switch req.Method {
+		case "eth_getTransactionCount":
+			result = hexutil.Uint64(pendingNonce)
+		case "eth_getBlockByNumber":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"error":{"code":-32601,"message":"not supported"}}`, req.Id)))
+			return
+		case "eth_gasPrice":
+			result = hexutil.Uint64(1)
+		case "eth_chainId":
+			result = hexutil.Uint64(1337)
+		case "eth_sendRawTransaction":
+			var raw hexutil.Bytes
+			assert.Nil(t, json.Unmarshal(req.Params[0], &raw))
+			filler = new(types.Transaction)
+			assert.Nil(t, filler.UnmarshalBinary(raw))
+			// Filling the gap at 6 makes the queued transaction at 7 pending as well
+			pendingNonce = 8
+			result = filler.Hash()
+		case "eth_getTransactionByHash":
+			var fields map[string]interface{}
+			encoded, err := json.Marshal(filler)
+			assert.Nil(t, err)
+			assert.Nil(t, json.Unmarshal(encoded, &fields))
+			fields["blockNumber"] = "0x1"
+			fields["blockHash"] = common.HexToHash("0x1")
+			result = fields
+		case "eth_getTransactionReceipt":
+			result = &types.Receipt{
+				Status:      types.ReceiptStatusSuccessful,
+				TxHash:      filler.Hash(),
+				BlockHash:   common.HexToHash("0x1"),
+				BlockNumber: big.NewInt(1),
+				Logs:        []*types.Log{},
+			}
+		default:
+			t.Fatalf("Unexpected RPC method %s", req.Method)
+		}
+
+		encoded, err := json.Marshal(result)
+		assert.Nil(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":%s}`, req.Id, encoded)))
+	}))
+	defer server.Close()
+
+	rpcClient, err := rpc.DialHTTP(server.URL)
+	assert.Nil(t, err)
+	handler, err := NewProviderHandler(ethclient.NewClient(rpcClient), common.Bytes2Hex(crypto.FromECDSA(key)))
+	assert.Nil(t, err)
+	helper := &contractHelper{ProviderHandler: handler}
+
+	// The second of three transactions fails before being sent, leaving a gap at nonce 6
+	sendErr := errors.New("send failed")
+	txs, err := helper.sendParallel(context.Background(), 3, func(ctx context.Context, index int, options *TransactionOptions) (*types.Transaction, error) {
+		if index == 1 {
+			return nil, sendErr
+		}
+		if index == 0 {
+			mu.Lock()
+			pendingNonce = 6
+			mu.Unlock()
+		}
+		return types.NewTx(&types.LegacyTx{Nonce: options.Nonce.Uint64()}), nil
+	})
+
+	assert.ErrorIs(t, err, sendErr)
+	assert.Len(t, txs, 3)
+	assert.Equal(t, uint64(5), txs[0].Nonce())
+	assert.Nil(t, txs[1])
+	assert.Equal(t, uint64(7), txs[2].Nonce())
+
+	assert.NotNil(t, filler)
+	assert.Equal(t, uint64(6), filler.Nonce())
+	assert.Equal(t, handler.GetSignerAddress(), *filler.To())
+	assert.Equal(t, 0, filler.Value().Sign())
+}
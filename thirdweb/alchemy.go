@@ -0,0 +1,242 @@
+package thirdweb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Client for the Alchemy enhanced APIs, which index wallet assets so we don't have to enumerate
+// them on-chain
+type alchemyClient struct {
+	apiKey     string
+	httpClient *http.Client
+}
+
+type alchemyNFTsResponse struct {
+	OwnedNfts []struct {
+		Contract struct {
+			Address string `json:"address"`
+		} `json:"contract"`
+		Id struct {
+			TokenId       string `json:"tokenId"`
+			TokenMetadata struct {
+				TokenType string `json:"tokenType"`
+			} `json:"tokenMetadata"`
+		} `json:"id"`
+		Balance  string `json:"balance"`
+		TokenUri struct {
+			Raw string `json:"raw"`
+		} `json:"tokenUri"`
+		Metadata map[string]interface{} `json:"metadata"`
+	} `json:"ownedNfts"`
+	PageKey string `json:"pageKey"`
+}
+
+type alchemyTokenBalancesResponse struct {
+	Result struct {
+		TokenBalances []struct {
+			ContractAddress string `json:"contractAddress"`
+			TokenBalance    string `json:"tokenBalance"`
+		} `json:"tokenBalances"`
+	} `json:"result"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func newAlchemyClient(apiKey string, httpClient *http.Client) *alchemyClient {
+	return &alchemyClient{
+		apiKey:     apiKey,
+		httpClient: httpClient,
+	}
+}
+
+func getAlchemyNetwork(chainId ChainID) (string, error) {
+	switch chainId {
+	case MAINNET:
+		return "eth-mainnet", nil
+	case GOERLI:
+		return "eth-goerli", nil
+	case POLYGON:
+		return "polygon-mainnet", nil
+	case MUMBAI:
+		return "polygon-mumbai", nil
+	case OPTIMISM:
+		return "opt-mainnet", nil
+	case ARBITRUM:
+		return "arb-mainnet", nil
+	default:
+		return "", fmt.Errorf("Alchemy doesn't support chain ID %d", chainId)
+	}
+}
+
+func (alchemy *alchemyClient) getNFTs(ctx context.Context, chainId ChainID, owner string) ([]*OwnedNFT, error) {
+	network, err := getAlchemyNetwork(chainId)
+	if err != nil {
+		return nil, err
+	}
+
+	nfts := []*OwnedNFT{}
+	pageKey := ""
+	for {
+		query := url.Values{}
+		query.Set("owner", owner)
+		if pageKey != "" {
+			query.Set("pageKey", pageKey)
+		}
+
+		endpoint := fmt.Sprintf("https://%s.g.alchemy.com/nft/v2/%s/getNFTs?%s", network, alchemy.apiKey, query.Encode())
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := alchemy.do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		response := &alchemyNFTsResponse{}
+		if err := json.Unmarshal(body, response); err != nil {
+			return nil, &unmarshalError{body: string(body), typeName: "alchemyNFTsResponse", UnderlyingError: err}
+		}
+
+		for _, ownedNft := range response.OwnedNfts {
+			nft, err := mapAlchemyNFT(ownedNft.Contract.Address, ownedNft.Id.TokenId, ownedNft.Id.TokenMetadata.TokenType, ownedNft.Balance, ownedNft.TokenUri.Raw, ownedNft.Metadata)
+			if err != nil {
+				return nil, err
+			}
+			nfts = append(nfts, nft)
+		}
+
+		if response.PageKey == "" {
+			return nfts, nil
+		}
+		pageKey = response.PageKey
+	}
+}
+
+// Returns the non zero ERC20 balances of the owner by token contract address
+func (alchemy *alchemyClient) getTokenBalances(ctx context.Context, chainId ChainID, owner string) (map[string]*big.Int, error) {
+	network, err := getAlchemyNetwork(chainId)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "alchemy_getTokenBalances",
+		"params":  []interface{}{owner, "erc20"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("https://%s.g.alchemy.com/v2/%s", network, alchemy.apiKey)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := alchemy.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &alchemyTokenBalancesResponse{}
+	if err := json.Unmarshal(body, response); err != nil {
+		return nil, &unmarshalError{body: string(body), typeName: "alchemyTokenBalancesResponse", UnderlyingError: err}
+	}
+	if response.Error != nil {
+		return nil, fmt.Errorf("Alchemy request failed: %s", response.Error.Message)
+	}
+
+	balances := map[string]*big.Int{}
+	for _, tokenBalance := range response.Result.TokenBalances {
+		balance, ok := new(big.Int).SetString(strings.TrimPrefix(tokenBalance.TokenBalance, "0x"), 16)
+		if !ok || balance.Sign() == 0 {
+			continue
+		}
+		balances[tokenBalance.ContractAddress] = balance
+	}
+
+	return balances, nil
+}
+
+func (alchemy *alchemyClient) do(req *http.Request) ([]byte, error) {
+	res, err := alchemy.httpClient.Do(req)
+	if err != nil {
+		// The API key is part of the URL, which transport errors include
+		if urlErr, ok := err.(*url.Error); ok {
+			urlErr.URL = strings.ReplaceAll(urlErr.URL, alchemy.apiKey, "<api-key>")
+		}
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Alchemy request failed with status code %d: %s", res.StatusCode, string(body))
+	}
+
+	return body, nil
+}
+
+func mapAlchemyNFT(
+	contractAddress string,
+	tokenId string,
+	tokenType string,
+	balance string,
+	tokenUri string,
+	rawMetadata map[string]interface{},
+) (*OwnedNFT, error) {
+	// Token IDs are returned as zero padded hex strings
+	id, ok := new(big.Int).SetString(strings.TrimPrefix(tokenId, "0x"), 16)
+	if !ok {
+		return nil, fmt.Errorf("Invalid token id %s", tokenId)
+	}
+
+	quantity, err := strconv.Atoi(balance)
+	if err != nil {
+		quantity = 1
+	}
+
+	metadata := &NFTMetadata{}
+	if rawMetadata != nil {
+		// The metadata is arbitrary JSON, so we go through JSON to pick out the standard fields. The
+		// token ID comes from Alchemy instead, since some metadata uses non numeric ids
+		delete(rawMetadata, "id")
+		metadataBytes, err := json.Marshal(rawMetadata)
+		if err != nil {
+			return nil, err
+		}
+		// Metadata that doesn't follow the standard shouldn't fail the whole request
+		if err := json.Unmarshal(metadataBytes, metadata); err != nil {
+			metadata = &NFTMetadata{}
+		}
+	}
+	metadata.Id = id
+	metadata.Uri = tokenUri
+
+	return &OwnedNFT{
+		ContractAddress: contractAddress,
+		TokenId:         id,
+		TokenType:       tokenType,
+		Balance:         quantity,
+		Metadata:        metadata,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package thirdweb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const alchemyNFTsResponseJSON = `{
+	"ownedNfts": [{
+		"contract": {"address": "0x495f947276749ce646f68ac8c248420045cb7b5e"},
+		"id": {
+			"tokenId": "0x000000000000000000000000000000000000000000000000000000000000002a",
+			"tokenMetadata": {"tokenType": "ERC1155"}
+		},
+		"balance": "3",
+		"tokenUri": {"raw": "ipfs://QmHash/42"},
+		"metadata": {"id": "not a number", "name": "Sword", "image": "ipfs://QmImage"}
+	}, {
+		"contract": {"address": "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d"},
+		"id": {
+			"tokenId": "0x01",
+			"tokenMetadata": {"tokenType": "ERC721"}
+		},
+		"balance": "1",
+		"tokenUri": {"raw": "https://example.com/1"},
+		"metadata": {"name": ["not", "a", "string"]}
+	}],
+	"pageKey": ""
+}`
+
+func TestMapAlchemyNFT(t *testing.T) {
+	response := &alchemyNFTsResponse{}
+	assert.Nil(t, json.Unmarshal([]byte(alchemyNFTsResponseJSON), response))
+
+	nfts := []*OwnedNFT{}
+	for _, ownedNft := range response.OwnedNfts {
+		nft, err := mapAlchemyNFT(ownedNft.Contract.Address, ownedNft.Id.TokenId, ownedNft.Id.TokenMetadata.TokenType, ownedNft.Balance, ownedNft.TokenUri.Raw, ownedNft.Metadata)
+		assert.Nil(t, err)
+		nfts = append(nfts, nft)
+	}
+
+	assert.Equal(t, big.NewInt(42), nfts[0].TokenId)
+	assert.Equal(t, "ERC1155", nfts[0].TokenType)
+	assert.Equal(t, 3, nfts[0].Balance)
+	assert.Equal(t, "Sword", nfts[0].Metadata.Name)
+	assert.Equal(t, "ipfs://QmImage", nfts[0].Metadata.Image)
+	assert.Equal(t, big.NewInt(42), nfts[0].Metadata.Id)
+	assert.Equal(t, "ipfs://QmHash/42", nfts[0].Metadata.Uri)
+
+	// Metadata that doesn't follow the standard is dropped rather than failing the request
+	assert.Equal(t, big.NewInt(1), nfts[1].TokenId)
+	assert.Equal(t, "", nfts[1].Metadata.Name)
+	assert.Equal(t, "https://example.com/1", nfts[1].Metadata.Uri)
+
+	_, err := mapAlchemyNFT("0x1", "not hex", "ERC721", "1", "", nil)
+	assert.NotNil(t, err)
+}
+
+type failingTransport struct{}
+
+func (transport *failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestAlchemyErrorsHideApiKey(t *testing.T) {
+	alchemy := newAlchemyClient("secret-key", &http.Client{Transport: &failingTransport{}})
+
+	_, err := alchemy.getNFTs(context.Background(), MAINNET, "0x0000000000000000000000000000000000000001")
+	assert.NotNil(t, err)
+	assert.NotContains(t, err.Error(), "secret-key")
+	assert.Contains(t, err.Error(), "connection refused")
+
+	_, err = alchemy.getTokenBalances(context.Background(), MAINNET, "0x0000000000000000000000000000000000000001")
+	assert.NotNil(t, err)
+	assert.NotContains(t, err.Error(), "secret-key")
+}
@@ -0,0 +1,261 @@
+package thirdweb
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	ethAbi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	signerTypes "github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const biconomyRelayUrl = "https://api.biconomy.io/api/v2/meta-tx/native"
+
+const biconomyForwarderAbi = `[{
+	"type": "function",
+	"name": "getNonce",
+	"stateMutability": "view",
+	"inputs": [
+		{"name": "from", "type": "address"},
+		{"name": "batchId", "type": "uint256"}
+	],
+	"outputs": [{"name": "", "type": "uint256"}]
+}]`
+
+// Sends transactions gaslessly through the Biconomy relayer. Instead of broadcasting the signed
+// transaction, its call is wrapped in a forward request signed by the SDK wallet, which Biconomy
+// submits to the trusted forwarder of the contract and pays the gas for.
+type biconomyBroadcaster struct {
+	handler         *ProviderHandler
+	apiKey          string
+	apiId           string
+	deadlineSeconds int
+	httpClient      *http.Client
+}
+
+type biconomyForwardRequest struct {
+	From          string `json:"from"`
+	To            string `json:"to"`
+	Token         string `json:"token"`
+	TxGas         uint64 `json:"txGas"`
+	TokenGasPrice string `json:"tokenGasPrice"`
+	BatchId       int    `json:"batchId"`
+	BatchNonce    string `json:"batchNonce"`
+	Deadline      int64  `json:"deadline"`
+	Data          string `json:"data"`
+}
+
+type biconomyRelayResponse struct {
+	TxHash string `json:"txHash"`
+	Log    string `json:"log"`
+}
+
+func newBiconomyBroadcaster(handler *ProviderHandler, apiKey string, apiId string, deadlineSeconds int, httpClient *http.Client) *biconomyBroadcaster {
+	return &biconomyBroadcaster{
+		handler:         handler,
+		apiKey:          apiKey,
+		apiId:           apiId,
+		deadlineSeconds: deadlineSeconds,
+		httpClient:      httpClient,
+	}
+}
+
+func (broadcaster *biconomyBroadcaster) sponsorsGas() {}
+
+func (broadcaster *biconomyBroadcaster) Send(ctx context.Context, tx *types.Transaction) (common.Hash, error) {
+	if tx.To() == nil {
+		return common.Hash{}, fmt.Errorf("Biconomy can't relay contract deployments")
+	}
+
+	privateKey := broadcaster.handler.GetPrivateKey()
+	if privateKey == nil {
+		return common.Hash{}, &noSignerError{typeName: "biconomy"}
+	}
+
+	chainId, err := broadcaster.handler.GetChainID(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	forwarderAddress, err := getContractAddressByChainId(ChainID(chainId.Int64()), "BiconomyForwarder")
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if forwarderAddress == zeroAddress {
+		return common.Hash{}, fmt.Errorf("Biconomy doesn't support chain ID %d", chainId)
+	}
+
+	from := broadcaster.handler.GetSignerAddress()
+	nonce, err := broadcaster.getNonce(ctx, common.HexToAddress(forwarderAddress), from)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	request := &biconomyForwardRequest{
+		From:          from.Hex(),
+		To:            tx.To().Hex(),
+		Token:         zeroAddress,
+		TxGas:         tx.Gas(),
+		TokenGasPrice: "0",
+		BatchId:       0,
+		BatchNonce:    nonce.String(),
+		Deadline:      time.Now().Unix() + int64(broadcaster.deadlineSeconds),
+		Data:          hexutil.Encode(tx.Data()),
+	}
+
+	typedData := generateBiconomyMessage(chainId, forwarderAddress, request, tx.Data())
+
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
+	sigHash := crypto.Keccak256(rawData)
+
+	signatureHash, err := crypto.Sign(sigHash, privateKey)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	// We need this to correct v = 0,1 to v = 27,28 - or else all will break
+	if signatureHash[64] == 0 || signatureHash[64] == 1 {
+		signatureHash[64] += 27
+	}
+
+	return broadcaster.relay(ctx, request, hexutil.Encode(domainSeparator), "0x"+hex.EncodeToString(signatureHash), tx.Gas())
+}
+
+func (broadcaster *biconomyBroadcaster) getNonce(ctx context.Context, forwarder common.Address, from common.Address) (*big.Int, error) {
+	forwarderAbi, err := ethAbi.JSON(strings.NewReader(biconomyForwarderAbi))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := forwarderAbi.Pack("getNonce", from, big.NewInt(0))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := broadcaster.handler.GetProvider().CallContract(ctx, ethereum.CallMsg{To: &forwarder, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := forwarderAbi.Unpack("getNonce", result)
+	if err != nil {
+		return nil, err
+	}
+
+	return values[0].(*big.Int), nil
+}
+
+func (broadcaster *biconomyBroadcaster) relay(
+	ctx context.Context,
+	request *biconomyForwardRequest,
+	domainSeparator string,
+	signature string,
+	gasLimit uint64,
+) (common.Hash, error) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"from":          request.From,
+		"to":            request.To,
+		"apiId":         broadcaster.apiId,
+		"params":        []interface{}{request, domainSeparator, signature},
+		"gasLimit":      hexutil.EncodeUint64(gasLimit),
+		"signatureType": "EIP712_SIGN",
+	})
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, biconomyRelayUrl, bytes.NewReader(payload))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	req.Header.Set("Content-Type", "application/json;charset=utf-8")
+	req.Header.Set("x-api-key", broadcaster.apiKey)
+
+	res, err := broadcaster.httpClient.Do(req)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	response := &biconomyRelayResponse{}
+	if err := json.Unmarshal(body, response); err != nil {
+		return common.Hash{}, &unmarshalError{body: string(body), typeName: "biconomyRelayResponse", UnderlyingError: err}
+	}
+
+	if res.StatusCode != http.StatusOK || response.TxHash == "" {
+		return common.Hash{}, fmt.Errorf("Biconomy failed to relay the transaction with status code %d: %s", res.StatusCode, response.Log)
+	}
+
+	return common.HexToHash(response.TxHash), nil
+}
+
+func generateBiconomyMessage(chainId *big.Int, forwarderAddress string, request *biconomyForwardRequest, data []byte) *signerTypes.TypedData {
+	return &signerTypes.TypedData{
+		Types: signerTypes.Types{
+			"ERC20ForwardRequest": []signerTypes.Type{
+				{Name: "from", Type: "address"},
+				{Name: "to", Type: "address"},
+				{Name: "token", Type: "address"},
+				{Name: "txGas", Type: "uint256"},
+				{Name: "tokenGasPrice", Type: "uint256"},
+				{Name: "batchId", Type: "uint256"},
+				{Name: "batchNonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+				{Name: "data", Type: "bytes"},
+			},
+			"EIP712Domain": []signerTypes.Type{
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "verifyingContract", Type: "address"},
+				{Name: "salt", Type: "bytes32"},
+			},
+		},
+		PrimaryType: "ERC20ForwardRequest",
+		Domain: signerTypes.TypedDataDomain{
+			Name:              "Biconomy Forwarder",
+			Version:           "1",
+			VerifyingContract: forwarderAddress,
+			// Biconomy puts the chain ID in the salt rather than the chainId field
+			Salt: hexutil.Encode(math.U256Bytes(new(big.Int).Set(chainId))),
+		},
+		Message: signerTypes.TypedDataMessage{
+			"from":          request.From,
+			"to":            request.To,
+			"token":         request.Token,
+			"txGas":         fmt.Sprintf("%v", request.TxGas),
+			"tokenGasPrice": request.TokenGasPrice,
+			"batchId":       fmt.Sprintf("%v", request.BatchId),
+			"batchNonce":    request.BatchNonce,
+			"deadline":      fmt.Sprintf("%v", request.Deadline),
+			"data":          data,
+		},
+	}
+}
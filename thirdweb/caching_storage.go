@@ -0,0 +1,229 @@
+package thirdweb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// CachingStorage wraps a storage implementation and remembers the URIs of previous uploads
+// in a local content-addressed cache, keyed by the SHA-256 of the uploaded data. Uploading
+// identical data again returns the cached URI instead of re-uploading it.
+//
+// Example
+//
+//	cache, err := thirdweb.NewCachingStorage(&sdk.Storage, "")
+//	uri, err := cache.Upload(context.Background(), metadata, "", "")
+type CachingStorage struct {
+	storage  storage
+	cacheDir string
+}
+
+type cachedUpload struct {
+	BaseUri string   `json:"baseUri"`
+	Uris    []string `json:"uris"`
+}
+
+// NewCachingStorage
+//
+// Create a caching layer around an existing storage implementation.
+//
+// storage: the storage to upload to on cache misses, for example &sdk.Storage
+//
+// cacheDir: the directory to store cache entries in, defaults to ~/.thirdweb/cache if empty
+//
+// returns: the caching storage
+func NewCachingStorage(storage storage, cacheDir string) (*CachingStorage, error) {
+	if cacheDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+
+		cacheDir = filepath.Join(home, ".thirdweb", "cache")
+	}
+
+	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+		return nil, err
+	}
+
+	return &CachingStorage{
+		storage:  storage,
+		cacheDir: cacheDir,
+	}, nil
+}
+
+// Get
+//
+// Get data at a given URI from the underlying storage.
+//
+// uri: the URI to fetch data from
+//
+// returns: byte data at the URI
+func (cache *CachingStorage) Get(ctx context.Context, uri string) ([]byte, error) {
+	return cache.storage.Get(ctx, uri)
+}
+
+// Upload
+//
+// Upload a generic payload, returning the cached URI if the same payload was uploaded before.
+//
+// data: the individual data to upload
+//
+// contractAddress: the optional contractAddress upload is being called from
+//
+// signerAddress: the optional signerAddress upload is being called from
+//
+// returns: the URI of the upload
+func (cache *CachingStorage) Upload(ctx context.Context, data map[string]interface{}, contractAddress string, signerAddress string) (string, error) {
+	baseUriWithUris, err := cache.UploadBatch(ctx, []map[string]interface{}{data}, 0, contractAddress, signerAddress)
+	if err != nil {
+		return "", err
+	}
+
+	return baseUriWithUris.uris[0], nil
+}
+
+// UploadBatch
+//
+// Upload a batch of generic payloads, returning the cached URIs if the same batch was uploaded
+// before. Batches that contain files (io.Reader values) are always uploaded.
+//
+// data: the array of data to upload
+//
+// contractAddress: the optional contractAddress upload is being called from
+//
+// signerAddress: the optional signerAddress upload is being called from
+//
+// returns: the base URI of the upload folder with the URIs of each subfile
+func (cache *CachingStorage) UploadBatch(ctx context.Context, data []map[string]interface{}, fileStartNumber int, contractAddress string, signerAddress string) (*baseUriWithUris, error) {
+	// Files can't be hashed by their JSON encoding, so skip the cache for them entirely
+	files, err := buildFilePropertiesMap(data, []interface{}{})
+	if err != nil {
+		return nil, err
+	}
+	if len(files) > 0 {
+		return cache.storage.UploadBatch(ctx, data, fileStartNumber, contractAddress, signerAddress)
+	}
+
+	encoded, err := json.Marshal(struct {
+		FileStartNumber int                      `json:"fileStartNumber"`
+		Data            []map[string]interface{} `json:"data"`
+	}{fileStartNumber, data})
+	if err != nil {
+		return nil, err
+	}
+
+	hasher := sha256.New()
+	hasher.Write([]byte("batch:"))
+	hasher.Write(encoded)
+	key := cacheKey(hasher)
+
+	if cached, ok := cache.lookup(key); ok && len(cached.Uris) == len(data) {
+		return &baseUriWithUris{baseUri: cached.BaseUri, uris: cached.Uris}, nil
+	}
+
+	baseUriWithUris, err := cache.storage.UploadBatch(ctx, data, fileStartNumber, contractAddress, signerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.store(key, &cachedUpload{BaseUri: baseUriWithUris.baseUri, Uris: baseUriWithUris.uris})
+	return baseUriWithUris, nil
+}
+
+// UploadWithProgress
+//
+// Upload a single file, returning the cached URI if a file with the same contents was uploaded
+// before. The file is spooled to a temporary file while it is hashed, so it is never held in
+// memory in full.
+//
+// data: the file contents to upload
+//
+// size: the total size of the file in bytes
+//
+// progressFn: optional callback invoked with the number of bytes uploaded so far
+//
+// returns: the URI of the upload
+func (cache *CachingStorage) UploadWithProgress(ctx context.Context, data io.Reader, size int64, progressFn func(uploaded, total int64)) (string, error) {
+	spool, err := ioutil.TempFile("", "thirdweb-upload-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(spool.Name())
+	defer spool.Close()
+
+	hasher := sha256.New()
+	hasher.Write([]byte("file:"))
+	if _, err := io.Copy(io.MultiWriter(spool, hasher), data); err != nil {
+		return "", err
+	}
+	key := cacheKey(hasher)
+
+	if cached, ok := cache.lookup(key); ok && len(cached.Uris) == 1 {
+		if progressFn != nil {
+			progressFn(size, size)
+		}
+		return cached.Uris[0], nil
+	}
+
+	if _, err := spool.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	uri, err := cache.storage.UploadWithProgress(ctx, spool, size, progressFn)
+	if err != nil {
+		return "", err
+	}
+
+	cache.store(key, &cachedUpload{Uris: []string{uri}})
+	return uri, nil
+}
+
+func cacheKey(hasher hash.Hash) string {
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+func (cache *CachingStorage) lookup(key string) (*cachedUpload, bool) {
+	body, err := ioutil.ReadFile(filepath.Join(cache.cacheDir, key))
+	if err != nil {
+		return nil, false
+	}
+
+	var cached cachedUpload
+	if err := json.Unmarshal(body, &cached); err != nil {
+		return nil, false
+	}
+
+	return &cached, true
+}
+
+// store writes a cache entry, ignoring failures since a missing entry only costs a re-upload
+func (cache *CachingStorage) store(key string, upload *cachedUpload) {
+	body, err := json.Marshal(upload)
+	if err != nil {
+		return
+	}
+
+	tmp, err := ioutil.TempFile(cache.cacheDir, key+".tmp-*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(body); err != nil {
+		tmp.Close()
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		return
+	}
+
+	_ = os.Rename(tmp.Name(), filepath.Join(cache.cacheDir, key))
+}
@@ -0,0 +1,84 @@
+package thirdweb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockStorage struct {
+	uploads int
+}
+
+func (storage *mockStorage) Get(ctx context.Context, uri string) ([]byte, error) {
+	return nil, nil
+}
+
+func (storage *mockStorage) Upload(ctx context.Context, data map[string]interface{}, contractAddress string, signerAddress string) (string, error) {
+	storage.uploads += 1
+	return fmt.Sprintf("ipfs://upload%d/0", storage.uploads), nil
+}
+
+func (storage *mockStorage) UploadBatch(ctx context.Context, data []map[string]interface{}, fileStartNumber int, contractAddress string, signerAddress string) (*baseUriWithUris, error) {
+	storage.uploads += 1
+	baseUri := fmt.Sprintf("ipfs://upload%d/", storage.uploads)
+
+	uris := []string{}
+	for i := range data {
+		uris = append(uris, fmt.Sprintf("%v%d", baseUri, i+fileStartNumber))
+	}
+
+	return &baseUriWithUris{baseUri: baseUri, uris: uris}, nil
+}
+
+func (storage *mockStorage) UploadWithProgress(ctx context.Context, data io.Reader, size int64, progressFn func(uploaded, total int64)) (string, error) {
+	if _, err := ioutil.ReadAll(data); err != nil {
+		return "", err
+	}
+
+	storage.uploads += 1
+	return fmt.Sprintf("ipfs://upload%d/0", storage.uploads), nil
+}
+
+func TestCachingStorageSkipsDuplicateUploads(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thirdweb-cache")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	inner := &mockStorage{}
+	cache, err := NewCachingStorage(inner, dir)
+	assert.Nil(t, err)
+
+	ctx := context.Background()
+
+	first, err := cache.Upload(ctx, map[string]interface{}{"name": "NFT"}, "", "")
+	assert.Nil(t, err)
+	second, err := cache.Upload(ctx, map[string]interface{}{"name": "NFT"}, "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 1, inner.uploads)
+
+	_, err = cache.Upload(ctx, map[string]interface{}{"name": "Other NFT"}, "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, inner.uploads)
+
+	fileUri, err := cache.UploadWithProgress(ctx, bytes.NewReader([]byte("video")), 5, nil)
+	assert.Nil(t, err)
+	cachedFileUri, err := cache.UploadWithProgress(ctx, bytes.NewReader([]byte("video")), 5, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fileUri, cachedFileUri)
+	assert.Equal(t, 3, inner.uploads)
+
+	// Metadata with embedded files is always uploaded
+	_, err = cache.Upload(ctx, map[string]interface{}{"image": bytes.NewReader([]byte("image"))}, "", "")
+	assert.Nil(t, err)
+	_, err = cache.Upload(ctx, map[string]interface{}{"image": bytes.NewReader([]byte("image"))}, "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 5, inner.uploads)
+}
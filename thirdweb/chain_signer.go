@@ -0,0 +1,79 @@
+package thirdweb
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// StarkNet chain IDs are the short strings SN_MAIN and SN_SEPOLIA encoded as numbers
+var starknetChainIds = map[string]bool{
+	"534e5f4d41494e":       true,
+	"534e5f5345504f4c4941": true,
+}
+
+// A chain signer signs transactions in the format a specific chain accepts. The right signer is
+// picked from the chain ID when signing with a private key.
+type ChainSigner interface {
+	SignTx(tx *types.Transaction, privateKey *ecdsa.PrivateKey) (*types.Transaction, error)
+}
+
+// Signs any transaction type go-ethereum supports. This covers Optimism and zkSync Era, which both
+// accept regular EIP-1559 transactions from accounts. Optimism deposit transactions are created
+// from L1 and never signed by an account, and zkSync's EIP-712 transactions (type 113) are only
+// needed for paymasters and factory dependencies, which go-ethereum transactions can't carry.
+type evmChainSigner struct {
+	signer types.Signer
+}
+
+func (signer *evmChainSigner) SignTx(tx *types.Transaction, privateKey *ecdsa.PrivateKey) (*types.Transaction, error) {
+	return types.SignTx(tx, signer.signer, privateKey)
+}
+
+// Signs transactions as EIP-155 legacy transactions for chains that don't accept typed
+// transactions, like Polygon zkEVM
+type legacyChainSigner struct {
+	signer types.Signer
+}
+
+func (signer *legacyChainSigner) SignTx(tx *types.Transaction, privateKey *ecdsa.PrivateKey) (*types.Transaction, error) {
+	if tx.Type() != types.LegacyTxType {
+		// The max fee is what the sender already agreed to pay per gas, so it becomes the gas price
+		tx = types.NewTx(&types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: tx.GasFeeCap(),
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		})
+	}
+
+	return types.SignTx(tx, signer.signer, privateKey)
+}
+
+type unsupportedChainSigner struct {
+	chainName string
+}
+
+func (signer *unsupportedChainSigner) SignTx(tx *types.Transaction, privateKey *ecdsa.PrivateKey) (*types.Transaction, error) {
+	return nil, fmt.Errorf("Signing transactions for %s is not supported, it is not an EVM chain", signer.chainName)
+}
+
+func getChainSigner(chainId *big.Int) (ChainSigner, error) {
+	if chainId == nil {
+		return nil, fmt.Errorf("Chain ID is required to sign transactions")
+	}
+
+	if starknetChainIds[chainId.Text(16)] {
+		return &unsupportedChainSigner{"StarkNet"}, nil
+	}
+
+	if chainId.IsInt64() && chainId.Int64() == POLYGON_ZKEVM {
+		return &legacyChainSigner{types.NewEIP155Signer(chainId)}, nil
+	}
+
+	return &evmChainSigner{types.LatestSignerForChainID(chainId)}, nil
+}
@@ -0,0 +1,151 @@
+package thirdweb
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var bigIntType = reflect.TypeOf(&big.Int{})
+
+// CoerceArgs
+//
+// # Convert arguments to the Go types the ABI encoder expects for the inputs of a method
+//
+// Integers can be passed as any Go integer, as a float64 without a fractional part, as a decimal
+// string or as a *big.Int, and are converted to *big.Int or to the sized Go integer the encoder
+// requires. Addresses can be passed as a hex string or a common.Address. Other arguments are
+// passed through unchanged.
+//
+// abiMethod: the method the arguments are for
+//
+// args: the arguments to convert, in the order of the method inputs
+//
+// returns: the converted arguments
+//
+// Example
+//
+//	method := contract.GetABI().Methods["transfer"]
+//	args, err := thirdweb.CoerceArgs(method, []interface{}{"{{wallet_address}}", "1000000000000000000"})
+func CoerceArgs(abiMethod abi.Method, args []interface{}) ([]interface{}, error) {
+	return coerceArgs(abiMethod, args, false)
+}
+
+func coerceArgs(abiMethod abi.Method, args []interface{}, strictChecksums bool) ([]interface{}, error) {
+	if len(abiMethod.Inputs) != len(args) {
+		return nil, fmt.Errorf(
+			"function '%s' requires %d arguments, but %d arguments were provided.\nExpected function signature '%s'",
+			abiMethod.Name,
+			len(abiMethod.Inputs),
+			len(args),
+			abiMethod.Sig,
+		)
+	}
+
+	typedArgs := []interface{}{}
+	for i, arg := range args {
+		input := abiMethod.Inputs[i]
+		typedArg, err := coerceArg(input.Type, arg, strictChecksums)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d (%v) %w", i, input.Name, err)
+		}
+
+		typedArgs = append(typedArgs, typedArg)
+	}
+
+	return typedArgs, nil
+}
+
+func coerceArg(abiType abi.Type, arg interface{}, strictChecksums bool) (interface{}, error) {
+	switch abiType.T {
+	case abi.AddressTy:
+		switch value := arg.(type) {
+		case common.Address:
+			return value, nil
+		case string:
+			if !isValidAddress(value, strictChecksums) {
+				return nil, fmt.Errorf("is not a valid address: %w", ErrInvalidAddress)
+			}
+			return common.HexToAddress(value), nil
+		default:
+			return nil, fmt.Errorf("should be of type 'string', but type '%v' was provided", reflect.TypeOf(arg))
+		}
+	case abi.IntTy, abi.UintTy:
+		number, err := toBigInt(arg)
+		if err != nil {
+			return nil, err
+		}
+		if abiType.T == abi.UintTy && number.Sign() < 0 {
+			return nil, fmt.Errorf("can't be negative for type '%s'", abiType.String())
+		}
+
+		// The encoder takes *big.Int for large integers and the exact Go type for the rest
+		goType := abiType.GetType()
+		if goType == bigIntType {
+			return number, nil
+		}
+
+		value := reflect.New(goType).Elem()
+		if abiType.T == abi.UintTy {
+			if !number.IsUint64() || value.OverflowUint(number.Uint64()) {
+				return nil, fmt.Errorf("overflows type '%s'", abiType.String())
+			}
+			value.SetUint(number.Uint64())
+		} else {
+			if !number.IsInt64() || value.OverflowInt(number.Int64()) {
+				return nil, fmt.Errorf("overflows type '%s'", abiType.String())
+			}
+			value.SetInt(number.Int64())
+		}
+		return value.Interface(), nil
+	default:
+		return arg, nil
+	}
+}
+
+func toBigInt(arg interface{}) (*big.Int, error) {
+	switch value := arg.(type) {
+	case *big.Int:
+		return value, nil
+	case big.Int:
+		return &value, nil
+	case int:
+		return big.NewInt(int64(value)), nil
+	case int8:
+		return big.NewInt(int64(value)), nil
+	case int16:
+		return big.NewInt(int64(value)), nil
+	case int32:
+		return big.NewInt(int64(value)), nil
+	case int64:
+		return big.NewInt(value), nil
+	case uint:
+		return new(big.Int).SetUint64(uint64(value)), nil
+	case uint8:
+		return new(big.Int).SetUint64(uint64(value)), nil
+	case uint16:
+		return new(big.Int).SetUint64(uint64(value)), nil
+	case uint32:
+		return new(big.Int).SetUint64(uint64(value)), nil
+	case uint64:
+		return new(big.Int).SetUint64(value), nil
+	case float64:
+		if math.IsInf(value, 0) || math.IsNaN(value) || value != math.Trunc(value) {
+			return nil, fmt.Errorf("should be a whole number, but '%v' was provided", value)
+		}
+		number, _ := new(big.Float).SetFloat64(value).Int(nil)
+		return number, nil
+	case string:
+		number, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return nil, fmt.Errorf("should be a decimal number, but '%s' was provided", value)
+		}
+		return number, nil
+	default:
+		return nil, fmt.Errorf("should be a number, but type '%v' was provided", reflect.TypeOf(arg))
+	}
+}
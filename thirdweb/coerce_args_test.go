@@ -0,0 +1,69 @@
+package thirdweb
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestMethod(t *testing.T, types ...string) abi.Method {
+	inputs := abi.Arguments{}
+	for _, typeName := range types {
+		abiType, err := abi.NewType(typeName, "", nil)
+		assert.Nil(t, err)
+		inputs = append(inputs, abi.Argument{Name: typeName, Type: abiType})
+	}
+	return abi.NewMethod("test", "test", abi.Function, "nonpayable", false, false, inputs, abi.Arguments{})
+}
+
+func TestCoerceArgs(t *testing.T) {
+	method := newTestMethod(t, "address", "uint256", "uint256", "int256", "uint8", "string")
+	address := "0x71C7656EC7ab88b098defB751B7401B5f6d8976F"
+
+	args, err := CoerceArgs(method, []interface{}{address, "1000000000000000000000", float64(5), int64(-3), 7, "hello"})
+	assert.Nil(t, err)
+	assert.Equal(t, common.HexToAddress(address), args[0])
+	expected, _ := new(big.Int).SetString("1000000000000000000000", 10)
+	assert.Equal(t, expected, args[1])
+	assert.Equal(t, big.NewInt(5), args[2])
+	assert.Equal(t, big.NewInt(-3), args[3])
+	assert.Equal(t, uint8(7), args[4])
+	assert.Equal(t, "hello", args[5])
+
+	_, err = CoerceArgs(method, []interface{}{address, 1})
+	assert.NotNil(t, err)
+}
+
+func TestCoerceArgsRejectsInvalidValues(t *testing.T) {
+	_, err := CoerceArgs(newTestMethod(t, "address"), []interface{}{"0x123"})
+	assert.True(t, errors.Is(err, ErrInvalidAddress))
+
+	for _, arg := range []interface{}{float64(1.5), "1.5", -1, true} {
+		_, err = CoerceArgs(newTestMethod(t, "uint256"), []interface{}{arg})
+		assert.NotNil(t, err, "%v", arg)
+	}
+
+	_, err = CoerceArgs(newTestMethod(t, "uint8"), []interface{}{256})
+	assert.NotNil(t, err)
+}
+
+func TestCoerceArgsStrictChecksums(t *testing.T) {
+	method := newTestMethod(t, "address")
+	checksummed := "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"
+
+	_, err := coerceArgs(method, []interface{}{checksummed}, true)
+	assert.Nil(t, err)
+
+	for _, address := range []string{strings.ToLower(checksummed), "0xc02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"} {
+		_, err = coerceArgs(method, []interface{}{address}, true)
+		assert.True(t, errors.Is(err, ErrInvalidAddress), address)
+
+		_, err = coerceArgs(method, []interface{}{address}, false)
+		assert.Nil(t, err)
+	}
+}
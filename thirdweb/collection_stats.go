@@ -0,0 +1,164 @@
+package thirdweb
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Get the collection stats
+//
+// @extension: ERC721
+//
+// The stats are rebuilt from the Transfer events of the contract. The first call scans from the
+// deployment block, which needs an RPC that serves historical state, and later calls only scan the
+// new blocks.
+//
+// returns: the number of minted, burned and circulating NFTs and the number of distinct holders
+//
+// Example
+//
+//	stats, err := contract.ERC721.GetCollectionStats(context.Background())
+//	fmt.Println(stats.CirculatingSupply, stats.UniqueOwners)
+func (erc721 *ERC721) GetCollectionStats(ctx context.Context) (*CollectionStats, error) {
+	if err := erc721.syncOwnership(ctx); err != nil {
+		return nil, err
+	}
+
+	return erc721.ownership.stats(), nil
+}
+
+// Get the collection stats
+//
+// @extension: ERC1155
+//
+// The stats are rebuilt from the TransferSingle and TransferBatch events of the contract, scanning
+// from the deployment block, which needs an RPC that serves historical state.
+//
+// returns: the number of minted, burned and circulating NFTs, summed over all token IDs, and the
+// number of distinct holders
+//
+// Example
+//
+//	stats, err := contract.ERC1155.GetCollectionStats(context.Background())
+//	fmt.Println(stats.CirculatingSupply, stats.UniqueOwners)
+func (erc1155 *ERC1155) GetCollectionStats(ctx context.Context) (*CollectionStats, error) {
+	provider := erc1155.helper.GetProvider()
+
+	deployment, err := fetchContractDeployment(ctx, erc1155.helper.getAddress().String(), provider)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := provider.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	holdings := newErc1155Holdings()
+
+	// RPCs limit how many blocks a single log query can cover
+	for start := deployment.BlockNumber; start <= latest; start += logsBlockRange {
+		end := start + logsBlockRange - 1
+		if end > latest {
+			end = latest
+		}
+		opts := &bind.FilterOpts{Start: start, End: &end, Context: ctx}
+
+		singles, err := erc1155.token.FilterTransferSingle(opts, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		for singles.Next() {
+			holdings.apply(singles.Event.From, singles.Event.To, singles.Event.Id, singles.Event.Value)
+		}
+		err = singles.Error()
+		singles.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		batches, err := erc1155.token.FilterTransferBatch(opts, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		for batches.Next() {
+			for i, id := range batches.Event.Ids {
+				holdings.apply(batches.Event.From, batches.Event.To, id, batches.Event.Values[i])
+			}
+		}
+		err = batches.Error()
+		batches.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return holdings.stats(), nil
+}
+
+// The balances of every holder of an ERC1155 contract. Balances only depend on the sum of the
+// transfers, so TransferSingle and TransferBatch events can be applied in any order.
+type erc1155Holdings struct {
+	balances map[common.Address]map[string]*big.Int
+	minted   *big.Int
+	burned   *big.Int
+}
+
+func newErc1155Holdings() *erc1155Holdings {
+	return &erc1155Holdings{
+		balances: map[common.Address]map[string]*big.Int{},
+		minted:   big.NewInt(0),
+		burned:   big.NewInt(0),
+	}
+}
+
+func (holdings *erc1155Holdings) apply(from common.Address, to common.Address, tokenId *big.Int, value *big.Int) {
+	if from == (common.Address{}) {
+		holdings.minted.Add(holdings.minted, value)
+	} else {
+		holdings.balanceOf(from, tokenId).Sub(holdings.balanceOf(from, tokenId), value)
+	}
+
+	if to == (common.Address{}) {
+		holdings.burned.Add(holdings.burned, value)
+	} else {
+		holdings.balanceOf(to, tokenId).Add(holdings.balanceOf(to, tokenId), value)
+	}
+}
+
+func (holdings *erc1155Holdings) balanceOf(owner common.Address, tokenId *big.Int) *big.Int {
+	tokens, ok := holdings.balances[owner]
+	if !ok {
+		tokens = map[string]*big.Int{}
+		holdings.balances[owner] = tokens
+	}
+
+	balance, ok := tokens[tokenId.String()]
+	if !ok {
+		balance = big.NewInt(0)
+		tokens[tokenId.String()] = balance
+	}
+	return balance
+}
+
+func (holdings *erc1155Holdings) stats() *CollectionStats {
+	uniqueOwners := 0
+	for _, tokens := range holdings.balances {
+		for _, balance := range tokens {
+			if balance.Sign() > 0 {
+				uniqueOwners += 1
+				break
+			}
+		}
+	}
+
+	return &CollectionStats{
+		TotalMinted:       new(big.Int).Set(holdings.minted),
+		TotalBurned:       new(big.Int).Set(holdings.burned),
+		CirculatingSupply: new(big.Int).Sub(holdings.minted, holdings.burned),
+		UniqueOwners:      uniqueOwners,
+	}
+}
@@ -0,0 +1,46 @@
+package thirdweb
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErc1155HoldingsStats(t *testing.T) {
+	alice := common.HexToAddress("0x71C7656EC7ab88b098defB751B7401B5f6d8976F")
+	bob := common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	zero := common.Address{}
+
+	holdings := newErc1155Holdings()
+	holdings.apply(zero, alice, big.NewInt(0), big.NewInt(10))
+	holdings.apply(zero, alice, big.NewInt(1), big.NewInt(5))
+	holdings.apply(alice, bob, big.NewInt(0), big.NewInt(10))
+	holdings.apply(alice, zero, big.NewInt(1), big.NewInt(2))
+
+	stats := holdings.stats()
+	assert.Equal(t, big.NewInt(15), stats.TotalMinted)
+	assert.Equal(t, big.NewInt(2), stats.TotalBurned)
+	assert.Equal(t, big.NewInt(13), stats.CirculatingSupply)
+	assert.Equal(t, 2, stats.UniqueOwners)
+
+	// Alice no longer holds anything once her last tokens are burned
+	holdings.apply(alice, zero, big.NewInt(1), big.NewInt(3))
+	assert.Equal(t, 1, holdings.stats().UniqueOwners)
+}
+
+func TestErc721OwnershipStats(t *testing.T) {
+	alice := common.HexToAddress("0x71C7656EC7ab88b098defB751B7401B5f6d8976F")
+	ownership := &erc721Ownership{
+		owners: map[string]common.Address{"0": alice, "1": alice},
+		minted: 3,
+		burned: 1,
+	}
+
+	stats := ownership.stats()
+	assert.Equal(t, big.NewInt(3), stats.TotalMinted)
+	assert.Equal(t, big.NewInt(1), stats.TotalBurned)
+	assert.Equal(t, big.NewInt(2), stats.CirculatingSupply)
+	assert.Equal(t, 1, stats.UniqueOwners)
+}
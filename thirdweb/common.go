@@ -13,8 +13,10 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcutil/base58"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/fxamacker/cbor"
@@ -28,6 +30,9 @@ import (
 
 func fetchTokenMetadata(ctx context.Context, tokenId int, uri string, storage storage) (*NFTMetadata, error) {
 	if body, err := storage.Get(ctx, uri); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, &ErrGatewayTimeout{URL: uri}
+		}
 		return nil, err
 	} else {
 		metadata := &NFTMetadata{
@@ -140,9 +145,9 @@ func fetchCurrencyMetadata(ctx context.Context, provider *ethclient.Client, asse
 		}
 		nativeToken, err := getNativeTokenByChainId(ChainID(chainId.Int64()))
 		currency := &Currency{
-			nativeToken.name,
-			nativeToken.symbol,
-			nativeToken.decimals,
+			nativeToken.Name,
+			nativeToken.Symbol,
+			nativeToken.Decimals,
 		}
 		return currency, nil
 	} else {
@@ -171,15 +176,8 @@ func fetchCurrencyValue(ctx context.Context, provider *ethclient.Client, asset s
 		return nil, err
 	}
 
-	displayValue := formatUnits(price, metadata.Decimals)
-
-	currencyValue := &CurrencyValue{
-		metadata.Name,
-		metadata.Symbol,
-		metadata.Decimals,
-		price,
-		displayValue,
-	}
+	currencyValue := FormatCurrencyValue(price, metadata.Decimals, metadata.Symbol)
+	currencyValue.Name = metadata.Name
 	return currencyValue, nil
 }
 
@@ -277,7 +275,7 @@ func approveErc20Allowance(
 		return err
 	}
 
-	erc20, err := newContractHelper(common.HexToAddress(currencyAddress), provider, contractToApprove.GetRawPrivateKey())
+	erc20, err := newContractHelper(common.HexToAddress(currencyAddress), contractToApprove.ProviderHandler)
 	if err != nil {
 		return err
 	}
@@ -622,29 +620,95 @@ func fetchContractMetadataFromAddress(ctx context.Context, address string, provi
 	return metadata, nil
 }
 
+func fetchContractType(ctx context.Context, address string, provider *ethclient.Client) (ContractType, error) {
+	contractAddress := common.HexToAddress(address)
+
+	bytecode, err := provider.CodeAt(ctx, contractAddress, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if len(bytecode) == 0 {
+		return "", fmt.Errorf("Contract at '%s' does not exist", address)
+	}
+
+	// Prebuilt contracts expose their type directly, so we try that first
+	contract, err := abi.NewTokenERC721(contractAddress, provider)
+	if err != nil {
+		return "", err
+	}
+
+	if encodedType, err := contract.ContractType(&bind.CallOpts{Context: ctx}); err == nil {
+		remoteName := strings.TrimRight(string(encodedType[:]), "\x00")
+		if contractType, ok := getContractTypeByRemoteName(remoteName); ok {
+			return contractType, nil
+		}
+	}
+
+	erc165, err := abi.NewIERC165(contractAddress, provider)
+	if err != nil {
+		return "", err
+	}
+
+	interfaceIds := map[ContractType][4]byte{
+		ContractTypeERC20:   {0x36, 0x37, 0x2B, 0x07},
+		ContractTypeERC721:  {0x80, 0xAC, 0x58, 0xCD},
+		ContractTypeERC1155: {0xD9, 0xB6, 0x7A, 0x26},
+	}
+
+	type interfaceResult struct {
+		contractType ContractType
+		supported    bool
+	}
+
+	// Check all the interfaces in parallel, contracts that don't implement ERC165 will
+	// revert here which we treat the same as the interface not being supported
+	ch := make(chan interfaceResult)
+	for contractType, interfaceId := range interfaceIds {
+		go func(contractType ContractType, interfaceId [4]byte) {
+			supported, err := erc165.SupportsInterface(&bind.CallOpts{Context: ctx}, interfaceId)
+			ch <- interfaceResult{contractType, err == nil && supported}
+		}(contractType, interfaceId)
+	}
+
+	supported := map[ContractType]bool{}
+	for range interfaceIds {
+		result := <-ch
+		supported[result.contractType] = result.supported
+	}
+
+	for _, contractType := range []ContractType{ContractTypeERC1155, ContractTypeERC721, ContractTypeERC20} {
+		if supported[contractType] {
+			return contractType, nil
+		}
+	}
+
+	return ContractTypeCustom, nil
+}
+
 func extractMinimalProxyImplementationAddress(bytecode []byte) string {
 	bytecodeString := "0x" + hex.EncodeToString(bytecode)
 
 //  EIP-1167 clone minimal proxy - https://eips.ethereum.org/EIPS/eip-1167
-if strings.HasPrefix(bytecodeString, "0x363d3d373d3d3d363d73") {
+if strings.HasPrefix(bytecodeString, "0x363d3d373d3d3d363d73") && len(bytecodeString) >= 22+40 {
 	implementationAddress := bytecodeString[22 : 22+40]
 	return "0x" + implementationAddress
 }
 
 // Minimal Proxy with receive() from 0xSplits - https://github.com/0xSplits/splits-contracts/blob/c7b741926ec9746182d0d1e2c4c2046102e5d337/contracts/libraries/Clones.sol
-if strings.HasPrefix(bytecodeString, "0x36603057343d5230") {
+if strings.HasPrefix(bytecodeString, "0x36603057343d5230") && len(bytecodeString) >= 122+40 {
 	implementationAddress := bytecodeString[122 : 122+40]
 	return "0x" + implementationAddress
 }
 
 // 0age's minimal proxy - https://medium.com/coinmonks/the-more-minimal-proxy-5756ae08ee48
-if strings.HasPrefix(bytecodeString, "0x3d3d3d3d363d3d37363d73") {
+if strings.HasPrefix(bytecodeString, "0x3d3d3d3d363d3d37363d73") && len(bytecodeString) >= 24+40 {
 	implementationAddress := bytecodeString[24 : 24+40]
 	return "0x" + implementationAddress
 }
 
 // vyper's minimal proxy (uniswap v1) - https://etherscan.io/address/0x09cabec1ead1c0ba254b09efb3ee13841712be14#code
-if strings.HasPrefix(bytecodeString, "0x366000600037611000600036600073") {
+if strings.HasPrefix(bytecodeString, "0x366000600037611000600036600073") && len(bytecodeString) >= 32+40 {
 	implementationAddress := bytecodeString[32 : 32+40]
 	return "0x" + implementationAddress
 }
@@ -652,6 +716,125 @@ if strings.HasPrefix(bytecodeString, "0x366000600037611000600036600073") {
 return ""
 }
 
+// Binary searches for the first block with code at the address, which needs an archive node for
+// contracts older than the state the node keeps around
+func fetchContractDeployment(ctx context.Context, address string, provider *ethclient.Client) (*DeploymentInfo, error) {
+	contractAddress := common.HexToAddress(address)
+
+	code, err := provider.CodeAt(ctx, contractAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("Contract at '%s' does not exist", address)
+	}
+
+	high, err := provider.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	low := uint64(0)
+	for low < high {
+		mid := low + (high-low)/2
+		code, err := provider.CodeAt(ctx, contractAddress, new(big.Int).SetUint64(mid))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(code) > 0 {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+
+	block, err := provider.BlockByNumber(ctx, new(big.Int).SetUint64(low))
+	if err != nil {
+		return nil, err
+	}
+
+	deployment := &DeploymentInfo{
+		BlockNumber: low,
+		Timestamp:   time.Unix(int64(block.Time()), 0),
+	}
+
+	chainId, err := provider.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	signer := types.LatestSignerForChainID(chainId)
+
+	// Contracts created by a factory don't show up as the receipt's contract address, but they
+	// almost always emit an event while being initialized
+	for _, tx := range block.Transactions() {
+		receipt, err := provider.TransactionReceipt(ctx, tx.Hash())
+		if err != nil {
+			return nil, err
+		}
+
+		deployed := receipt.ContractAddress == contractAddress
+		for _, log := range receipt.Logs {
+			if log.Address == contractAddress {
+				deployed = true
+				break
+			}
+		}
+
+		if deployed {
+			from, err := types.Sender(signer, tx)
+			if err != nil {
+				return nil, err
+			}
+
+			deployment.DeployerAddress = from.String()
+			deployment.TransactionHash = tx.Hash().String()
+			break
+		}
+	}
+
+	return deployment, nil
+}
+
+// Returns the implementation address of an EIP-1967 proxy, either read directly from the
+// implementation slot (transparent and UUPS proxies) or from the beacon in the beacon slot
+// (beacon proxies). Returns an empty string if the contract is not an EIP-1967 proxy
+func fetchEIP1967ImplementationAddress(ctx context.Context, address string, provider *ethclient.Client) (string, error) {
+	slot, err := provider.StorageAt(ctx, common.HexToAddress(address), common.HexToHash(eip1967ImplementationSlot), nil)
+	if err != nil {
+		return "", err
+	}
+
+	implementationAddress := common.BytesToAddress(slot)
+	if implementationAddress != (common.Address{}) {
+		return implementationAddress.String(), nil
+	}
+
+	slot, err = provider.StorageAt(ctx, common.HexToAddress(address), common.HexToHash(eip1967BeaconSlot), nil)
+	if err != nil {
+		return "", err
+	}
+
+	beaconAddress := common.BytesToAddress(slot)
+	if beaconAddress == (common.Address{}) {
+		return "", nil
+	}
+
+	// implementation()
+	result, err := provider.CallContract(ctx, ethereum.CallMsg{
+		To:   &beaconAddress,
+		Data: hexutil.MustDecode("0x5c60da1b"),
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+	if len(result) < common.HashLength {
+		return "", fmt.Errorf("Beacon at '%s' did not return an implementation address", beaconAddress.String())
+	}
+
+	return common.BytesToAddress(result[:common.HashLength]).String(), nil
+}
+
 func resolveContractUriFromAddress(ctx context.Context, address string, provider *ethclient.Client) (string, error) {
 	bytecode, err := provider.CodeAt(ctx, common.HexToAddress(address), nil)
 	if err != nil {
@@ -667,11 +850,28 @@ func resolveContractUriFromAddress(ctx context.Context, address string, provider
 		return resolveContractUriFromAddress(ctx, implemntationAddress, provider)
 	}
 
+	// Upgradeable proxies keep their logic elsewhere, so the ABI has to come from the implementation
+	implemntationAddress, err = fetchEIP1967ImplementationAddress(ctx, address, provider)
+	if err != nil {
+		return "", err
+	}
+	if implemntationAddress != "" {
+		return resolveContractUriFromAddress(ctx, implemntationAddress, provider)
+	}
+
 	return extractIPFSHashFromBytecode(bytecode)
 }
 
 func extractIPFSHashFromBytecode(bytecode []byte) (string, error) {
+	if len(bytecode) < 2 {
+		return "", fmt.Errorf("Bytecode is too short to contain metadata")
+	}
+
+	// The last 2 bytes of the bytecode hold the length of the CBOR encoded metadata before them
 	cborLength := int(bytecode[len(bytecode)-2])*0x100 + int(bytecode[len(bytecode)-1])
+	if cborLength > len(bytecode)-2 {
+		return "", fmt.Errorf("Bytecode is too short to contain metadata of length %d", cborLength)
+	}
 	cborBytecode := bytecode[len(bytecode)-cborLength-2 : len(bytecode)-2]
 
 	cborData := map[string][]byte{}
@@ -0,0 +1,180 @@
+package thirdweb
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type CompiledContract struct {
+	Name     string
+	Abi      string
+	Bytecode []byte
+}
+
+// The contract entry of solc standard JSON, solc --combined-json and Hardhat artifacts
+type compilerOutputContract struct {
+	ContractName string          `json:"contractName"`
+	Abi          json.RawMessage `json:"abi"`
+	// Hardhat artifacts
+	Bytecode string `json:"bytecode"`
+	// solc --combined-json
+	Bin string `json:"bin"`
+	// solc standard JSON
+	Evm struct {
+		Bytecode struct {
+			Object string `json:"object"`
+		} `json:"bytecode"`
+	} `json:"evm"`
+}
+
+// ParseCompilerOutput
+//
+// # Extract the ABI and bytecode of a contract from compiler output
+//
+// Supports solc standard JSON output, solc --combined-json output, and Hardhat artifacts and
+// build info files.
+//
+// output: the compiler output JSON
+//
+// contractName: the name of the contract to extract, can be empty if the output only has one contract
+//
+// returns: the ABI and bytecode of the contract
+//
+// Example
+//
+//	output, err := ioutil.ReadFile("artifacts/contracts/Greeter.sol/Greeter.json")
+//	contract, err := thirdweb.ParseCompilerOutput(output, "Greeter")
+func ParseCompilerOutput(output []byte, contractName string) (*CompiledContract, error) {
+	var raw struct {
+		compilerOutputContract
+		Contracts json.RawMessage `json:"contracts"`
+		// Hardhat build info wraps the solc standard JSON output
+		Output *struct {
+			Contracts json.RawMessage `json:"contracts"`
+		} `json:"output"`
+	}
+	if err := json.Unmarshal(output, &raw); err != nil {
+		return nil, err
+	}
+
+	contracts := raw.Contracts
+	if raw.Output != nil {
+		contracts = raw.Output.Contracts
+	}
+
+	// A Hardhat artifact holds a single contract at the top level
+	if len(contracts) == 0 {
+		if raw.Abi == nil || raw.Bytecode == "" {
+			return nil, fmt.Errorf("Compiler output contains no contracts")
+		}
+		if contractName != "" && raw.ContractName != "" && raw.ContractName != contractName {
+			return nil, fmt.Errorf("Compiler output is for contract '%s', not '%s'", raw.ContractName, contractName)
+		}
+
+		return newCompiledContract(raw.ContractName, &raw.compilerOutputContract)
+	}
+
+	candidates, err := parseCompilerOutputContracts(contracts)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for name := range candidates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if contractName == "" {
+		if len(names) != 1 {
+			return nil, fmt.Errorf("Compiler output contains %d contracts, a contract name is required: %s", len(names), strings.Join(names, ", "))
+		}
+		contractName = names[0]
+	}
+
+	contract, ok := candidates[contractName]
+	if !ok {
+		return nil, fmt.Errorf("Contract '%s' not found in compiler output, found: %s", contractName, strings.Join(names, ", "))
+	}
+
+	return newCompiledContract(contractName, contract)
+}
+
+// Returns the contracts by name, from either the solc standard JSON layout (keyed by source file,
+// then contract name) or the --combined-json layout (keyed by "path:Name")
+func parseCompilerOutputContracts(contracts json.RawMessage) (map[string]*compilerOutputContract, error) {
+	candidates := map[string]*compilerOutputContract{}
+
+	combined := map[string]*compilerOutputContract{}
+	if err := json.Unmarshal(contracts, &combined); err == nil && isCombinedJson(combined) {
+		for key, contract := range combined {
+			name := key[strings.LastIndex(key, ":")+1:]
+			candidates[name] = contract
+		}
+		return candidates, nil
+	}
+
+	standard := map[string]map[string]*compilerOutputContract{}
+	if err := json.Unmarshal(contracts, &standard); err != nil {
+		return nil, err
+	}
+	for _, sourceContracts := range standard {
+		for name, contract := range sourceContracts {
+			candidates[name] = contract
+		}
+	}
+
+	return candidates, nil
+}
+
+func isCombinedJson(contracts map[string]*compilerOutputContract) bool {
+	for key, contract := range contracts {
+		if !strings.Contains(key, ":") || contract == nil || contract.Bin == "" {
+			return false
+		}
+	}
+	return len(contracts) > 0
+}
+
+func newCompiledContract(name string, contract *compilerOutputContract) (*CompiledContract, error) {
+	bytecode := contract.Bytecode
+	if bytecode == "" {
+		bytecode = contract.Bin
+	}
+	if bytecode == "" {
+		bytecode = contract.Evm.Bytecode.Object
+	}
+	if bytecode == "" || bytecode == "0x" {
+		return nil, fmt.Errorf("Contract '%s' has no bytecode, it may be abstract or an interface", name)
+	}
+
+	// Library placeholders look like __$<hash>$__ and need to be replaced with library addresses
+	if strings.Contains(bytecode, "__") {
+		return nil, fmt.Errorf("Contract '%s' has unlinked library references in its bytecode", name)
+	}
+
+	if !strings.HasPrefix(bytecode, "0x") {
+		bytecode = "0x" + bytecode
+	}
+	decoded, err := hexutil.Decode(bytecode)
+	if err != nil {
+		return nil, err
+	}
+
+	// Older versions of solc --combined-json encode the ABI as a JSON string
+	contractAbi := string(contract.Abi)
+	var abiString string
+	if err := json.Unmarshal(contract.Abi, &abiString); err == nil {
+		contractAbi = abiString
+	}
+
+	return &CompiledContract{
+		Name:     name,
+		Abi:      contractAbi,
+		Bytecode: decoded,
+	}, nil
+}
@@ -0,0 +1,55 @@
+package thirdweb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testCompilerAbi = `[{"type":"function","name":"greet","inputs":[],"outputs":[{"name":"","type":"string"}],"stateMutability":"view"}]`
+
+func TestParseCompilerOutputHardhatArtifact(t *testing.T) {
+	output := `{"contractName": "Greeter", "abi": ` + testCompilerAbi + `, "bytecode": "0x6080"}`
+
+	contract, err := ParseCompilerOutput([]byte(output), "")
+	assert.Nil(t, err)
+	assert.Equal(t, "Greeter", contract.Name)
+	assert.Equal(t, testCompilerAbi, contract.Abi)
+	assert.Equal(t, []byte{0x60, 0x80}, contract.Bytecode)
+
+	_, err = ParseCompilerOutput([]byte(output), "Other")
+	assert.NotNil(t, err)
+}
+
+func TestParseCompilerOutputSolcStandardJson(t *testing.T) {
+	output := `{"contracts": {"contracts/Greeter.sol": {
+		"Greeter": {"abi": ` + testCompilerAbi + `, "evm": {"bytecode": {"object": "6080"}}},
+		"IGreeter": {"abi": ` + testCompilerAbi + `, "evm": {"bytecode": {"object": ""}}}
+	}}}`
+
+	contract, err := ParseCompilerOutput([]byte(output), "Greeter")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0x60, 0x80}, contract.Bytecode)
+
+	// Interfaces have no bytecode to deploy
+	_, err = ParseCompilerOutput([]byte(output), "IGreeter")
+	assert.NotNil(t, err)
+
+	// The name is required when there are several contracts
+	_, err = ParseCompilerOutput([]byte(output), "")
+	assert.NotNil(t, err)
+
+	buildInfo := `{"output": ` + output + `}`
+	contract, err = ParseCompilerOutput([]byte(buildInfo), "Greeter")
+	assert.Nil(t, err)
+	assert.Equal(t, "Greeter", contract.Name)
+}
+
+func TestParseCompilerOutputSolcCombinedJson(t *testing.T) {
+	output := `{"contracts": {"contracts/Greeter.sol:Greeter": {"abi": ` + testCompilerAbi + `, "bin": "6080"}}}`
+
+	contract, err := ParseCompilerOutput([]byte(output), "Greeter")
+	assert.Nil(t, err)
+	assert.Equal(t, testCompilerAbi, contract.Abi)
+	assert.Equal(t, []byte{0x60, 0x80}, contract.Bytecode)
+}
@@ -0,0 +1,54 @@
+package thirdweb
+
+import "sync"
+
+// Caps the number of goroutines the SDK runs at once when fetching many tokens in parallel, so
+// large collections don't exhaust the HTTP connection pool. It's shared by every contract of an
+// SDK instance, and a nil limiter doesn't limit anything.
+type concurrencyLimiter struct {
+	mu sync.Mutex
+	// Buffered to the maximum concurrency, nil when there is no limit
+	slots chan struct{}
+}
+
+func newConcurrencyLimiter(maxConcurrency int) *concurrencyLimiter {
+	limiter := &concurrencyLimiter{}
+	limiter.setLimit(maxConcurrency)
+	return limiter
+}
+
+// A limit of zero or less removes the limit. Goroutines that are already running keep their slot
+// in the previous limit, so the new one only applies to the goroutines started afterwards.
+func (limiter *concurrencyLimiter) setLimit(maxConcurrency int) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	if maxConcurrency <= 0 {
+		limiter.slots = nil
+	} else {
+		limiter.slots = make(chan struct{}, maxConcurrency)
+	}
+}
+
+// Waits for a free slot, then runs fn in a new goroutine
+func (limiter *concurrencyLimiter) Go(fn func()) {
+	if limiter == nil {
+		go fn()
+		return
+	}
+
+	limiter.mu.Lock()
+	slots := limiter.slots
+	limiter.mu.Unlock()
+
+	if slots == nil {
+		go fn()
+		return
+	}
+
+	slots <- struct{}{}
+	go func() {
+		defer func() { <-slots }()
+		fn()
+	}()
+}
@@ -0,0 +1,81 @@
+package thirdweb
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func runLimited(limiter *concurrencyLimiter, count int) int {
+	var mu sync.Mutex
+	running := 0
+	maxRunning := 0
+
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		limiter.Go(func() {
+			defer wg.Done()
+
+			mu.Lock()
+			running += 1
+			if running > maxRunning {
+				maxRunning = running
+			}
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			running -= 1
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+
+	return maxRunning
+}
+
+func TestConcurrencyLimiter(t *testing.T) {
+	limiter := newConcurrencyLimiter(3)
+	assert.LessOrEqual(t, runLimited(limiter, 20), 3)
+
+	limiter.setLimit(1)
+	assert.Equal(t, 1, runLimited(limiter, 5))
+
+	limiter.setLimit(0)
+	assert.True(t, startsAll(limiter, 20))
+	assert.True(t, startsAll(nil, 20))
+}
+
+// Without a limit every goroutine is started right away, even if none of them finishes
+func startsAll(limiter *concurrencyLimiter, count int) bool {
+	var started sync.WaitGroup
+	release := make(chan struct{})
+	defer close(release)
+
+	started.Add(count)
+	go func() {
+		for i := 0; i < count; i++ {
+			limiter.Go(func() {
+				started.Done()
+				<-release
+			})
+		}
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		started.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(time.Second):
+		return false
+	}
+}
@@ -1,6 +1,9 @@
 package thirdweb
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 // SERVER URLS
 
@@ -13,6 +16,42 @@ const pinataIpfsUrl = "https://api.pinata.cloud/pinning/pinFileToIPFS"
 const zeroAddress = "0x0000000000000000000000000000000000000000"
 const nativeTokenAddress = "0xeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee"
 const defaultMerkleRoot = "0x0000000000000000000000000000000000000000000000000000000000000000"
+const defaultGasLimitMultiplier = 1.2
+const defaultBiconomyDeadlineSeconds = 3600
+
+// Number of blocks fetched per log query, since RPCs limit the block range of eth_getLogs
+const logsBlockRange = 5000
+
+// Number of NFTs fetched concurrently when listing all the NFTs of a drop
+const defaultNFTPageSize = 50
+
+// Number of goroutines an SDK instance runs at once when fetching many tokens in parallel
+const defaultMaxConcurrency = 50
+
+// Longest time a single NFT metadata fetch can take when fetching many NFTs in parallel
+const defaultMetadataFetchTimeout = 10 * time.Second
+
+// Longest time a GetOwned call shared by concurrent callers can take
+const defaultSharedRequestTimeout = 2 * time.Minute
+
+// bytes32(uint256(keccak256("eip1967.proxy.implementation")) - 1)
+const eip1967ImplementationSlot = "0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc"
+
+// bytes32(uint256(keccak256("eip1967.proxy.beacon")) - 1)
+const eip1967BeaconSlot = "0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50"
+
+// bytes32(uint256(keccak256("eip1967.proxy.admin")) - 1)
+const eip1967AdminSlot = "0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103"
+
+// Operators of the common NFT marketplaces checked by GetApprovedOperators
+var defaultKnownOperators = []string{
+	// OpenSea Seaport conduit
+	"0x1E0049783F008A0085193E00003D00cd54003c71",
+	// LooksRare ERC1155 transfer manager
+	"0xFED24eC7E22f573c2e08AEF55aA6797Ca2b3A051",
+	// Blur execution delegate
+	"0x00000000000111AbE46ff893f3B2fdF1F759a8A8",
+}
 
 // NATIVE TOKEN BY CHAIN
 
@@ -33,6 +72,10 @@ const (
 	OPTIMISM_TESTNET          = 69
 	ARBITRUM                  = 42161
 	ARBITRUM_TESTNET          = 421611
+	POLYGON_ZKEVM             = 1101
+	ZKSYNC_ERA                = 324
+	BSC                       = 56
+	BSC_TESTNET               = 97
 )
 
 func getNativeTokenByChainId(chainId ChainID) (*NativeToken, error) {
@@ -180,15 +223,78 @@ func getNativeTokenByChainId(chainId ChainID) (*NativeToken, error) {
 				"WETH",
 			},
 		}, nil
+	case BSC:
+		return &NativeToken{
+			"Binance Chain Native Token",
+			"BNB",
+			18,
+			&WrappedToken{
+				"0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c",
+				"Wrapped Binance Chain Token",
+				"WBNB",
+			},
+		}, nil
+	case BSC_TESTNET:
+		return &NativeToken{
+			"Binance Chain Native Token",
+			"TBNB",
+			18,
+			&WrappedToken{
+				"0xae13d989daC2f0dEbFf460aC112a837C89BAa7cd",
+				"Wrapped Binance Chain Testnet Token",
+				"WBNB",
+			},
+		}, nil
 	default:
 		return nil, errors.New("Unsupported chain id")
 	}
 }
 
+// CONTRACT TYPES
+
+type ContractType string
+
+const (
+	ContractTypeNFTCollection ContractType = "nft-collection"
+	ContractTypeEdition       ContractType = "edition"
+	ContractTypeToken         ContractType = "token"
+	ContractTypeNFTDrop       ContractType = "nft-drop"
+	ContractTypeEditionDrop   ContractType = "edition-drop"
+	ContractTypeMultiwrap     ContractType = "multiwrap"
+	ContractTypeMarketplace   ContractType = "marketplace"
+	ContractTypeERC20         ContractType = "erc20"
+	ContractTypeERC721        ContractType = "erc721"
+	ContractTypeERC1155       ContractType = "erc1155"
+	ContractTypeCustom        ContractType = "custom"
+)
+
+// Maps the name returned by contractType() on prebuilt contracts to its contract type
+func getContractTypeByRemoteName(remoteName string) (ContractType, bool) {
+	switch remoteName {
+	case "TokenERC721":
+		return ContractTypeNFTCollection, true
+	case "TokenERC1155":
+		return ContractTypeEdition, true
+	case "TokenERC20":
+		return ContractTypeToken, true
+	case "DropERC721":
+		return ContractTypeNFTDrop, true
+	case "DropERC1155":
+		return ContractTypeEditionDrop, true
+	case "Multiwrap":
+		return ContractTypeMultiwrap, true
+	case "Marketplace":
+		return ContractTypeMarketplace, true
+	default:
+		return "", false
+	}
+}
+
 // CONTRACT ADDRESSES BY CHAIN ID
 
 const twRegistryAddress = "0x7c487845f98938Bb955B1D5AD069d9a30e4131fd"
 const twFactoryAddress = "0x5DBC7B840baa9daBcBe9D2492E45D7244B54A2A0"
+const twContractPublisherAddress = "0xf5b896Ddb5146D5dA77efF4efBb3Eae36E300808"
 const ozDefenderForwarderAddress = "0xc82BbE41f2cF04e3a8efA18F7032BDD7f6d98a81"
 
 func getContractAddressByChainId(chainId ChainID, contractName string) (string, error) {
@@ -200,78 +306,91 @@ func getContractAddressByChainId(chainId ChainID, contractName string) (string,
 			"BiconomyForwarder": "0x84a0856b038eaAd1cC7E297cF34A7e72685A8693",
 			"TWFactory":         twFactoryAddress,
 			"TWRegistry":        twRegistryAddress,
+			"ContractPublisher": zeroAddress,
 		}
 	case RINKEBY:
 		addresses = map[string]string{
 			"BiconomyForwarder": "0xFD4973FeB2031D4409fB57afEE5dF2051b171104",
 			"TWFactory":         twFactoryAddress,
 			"TWRegistry":        twRegistryAddress,
+			"ContractPublisher": zeroAddress,
 		}
 	case GOERLI:
 		addresses = map[string]string{
 			"BiconomyForwarder": zeroAddress,
 			"TWFactory":         twFactoryAddress,
 			"TWRegistry":        twRegistryAddress,
+			"ContractPublisher": twContractPublisherAddress,
 		}
 	case POLYGON:
 		addresses = map[string]string{
 			"BiconomyForwarder": "0x86C80a8aa58e0A4fa09A69624c31Ab2a6CAD56b8",
 			"TWFactory":         twFactoryAddress,
 			"TWRegistry":        twRegistryAddress,
+			"ContractPublisher": twContractPublisherAddress,
 		}
 	case MUMBAI:
 		addresses = map[string]string{
 			"BiconomyForwarder": "0x9399BB24DBB5C4b782C70c2969F58716Ebbd6a3b",
 			"TWFactory":         twFactoryAddress,
 			"TWRegistry":        twRegistryAddress,
+			"ContractPublisher": twContractPublisherAddress,
 		}
 	case AVALANCHE:
 		addresses = map[string]string{
 			"BiconomyForwarder": "0x64CD353384109423a966dCd3Aa30D884C9b2E057",
 			"TWFactory":         twFactoryAddress,
 			"TWRegistry":        twRegistryAddress,
+			"ContractPublisher": zeroAddress,
 		}
 	case AVALANCHE_TESTNET:
 		addresses = map[string]string{
 			"BiconomyForwarder": "0x6271Ca63D30507f2Dcbf99B52787032506D75BBF",
 			"TWFactory":         twFactoryAddress,
 			"TWRegistry":        twRegistryAddress,
+			"ContractPublisher": zeroAddress,
 		}
 	case FANTOM:
 		addresses = map[string]string{
 			"BiconomyForwarder": zeroAddress,
 			"TWFactory":         twFactoryAddress,
 			"TWRegistry":        twRegistryAddress,
+			"ContractPublisher": zeroAddress,
 		}
 	case FANTOM_TESTNET:
 		addresses = map[string]string{
 			"BiconomyForwarder": zeroAddress,
 			"TWFactory":         twFactoryAddress,
 			"TWRegistry":        twRegistryAddress,
+			"ContractPublisher": zeroAddress,
 		}
 	case ARBITRUM:
 		addresses = map[string]string{
 			"BiconomyForwarder": zeroAddress,
 			"TWFactory":         "0xd24b3de085CFd8c54b94feAD08a7962D343E6DE0",
 			"TWRegistry":        "0x7c487845f98938Bb955B1D5AD069d9a30e4131fd",
+			"ContractPublisher": zeroAddress,
 		}
 	case ARBITRUM_TESTNET:
 		addresses = map[string]string{
 			"BiconomyForwarder": zeroAddress,
 			"TWFactory":         "0xb0435b47ad26115A39c59735b814f3769F07C2c1",
 			"TWRegistry":        "0xcF4c511551aE4dab1F997866FC3900cd2aaeC40D",
+			"ContractPublisher": zeroAddress,
 		}
 	case OPTIMISM:
 		addresses = map[string]string{
 			"BiconomyForwarder": zeroAddress,
 			"TWFactory":         "0xd24b3de085CFd8c54b94feAD08a7962D343E6DE0",
 			"TWRegistry":        "0x7c487845f98938Bb955B1D5AD069d9a30e4131fd",
+			"ContractPublisher": zeroAddress,
 		}
 	case OPTIMISM_TESTNET:
 		addresses = map[string]string{
 			"BiconomyForwarder": zeroAddress,
 			"TWFactory":         "0xd24b3de085CFd8c54b94feAD08a7962D343E6DE0",
 			"TWRegistry":        "0x7c487845f98938Bb955B1D5AD069d9a30e4131fd",
+			"ContractPublisher": zeroAddress,
 		}
 	default:
 		return "", errors.New("Unsupported chain id")
@@ -289,12 +408,12 @@ func getContractAddressByChainId(chainId ChainID, contractName string) (string,
 type ClaimEligibility string
 
 const (
-	NotEnoughSupply                ClaimEligibility = "There is not enough supply to claim."
-	AddressNotAllowed                               = "This address is not on the allowlist."
-	InsufficientBalance                             = "There isn't enough of the required currency in the wallet to pay for the claim."
-	NoActiveClaimPhase                              = "There is no active claim phase at the moment. Please check back in later."
-	NoClaimConditionSet                             = "There is no claim condition set."
-	ExceedsMaxClaimable											        = "The quantity of tokens to claim is above the remaining limit for this wallet."
-	NoWallet                                        = "No wallet connected."
-	Unknown                                         = "No claim conditions found."
+	NotEnoughSupply     ClaimEligibility = "There is not enough supply to claim."
+	AddressNotAllowed                    = "This address is not on the allowlist."
+	InsufficientBalance                  = "There isn't enough of the required currency in the wallet to pay for the claim."
+	NoActiveClaimPhase                   = "There is no active claim phase at the moment. Please check back in later."
+	NoClaimConditionSet                  = "There is no claim condition set."
+	ExceedsMaxClaimable                  = "The quantity of tokens to claim is above the remaining limit for this wallet."
+	NoWallet                             = "No wallet connected."
+	Unknown                              = "No claim conditions found."
 )
@@ -5,10 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"reflect"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/mitchellh/mapstructure"
 
 	"github.com/thirdweb-dev/go-sdk/v2/abi"
@@ -42,13 +43,10 @@ type ContractDeployer struct {
 	storage storage
 }
 
-func newContractDeployer(provider *ethclient.Client, privateKey string, storage storage) (*ContractDeployer, error) {
-	handler, err := NewProviderHandler(provider, privateKey)
-	if err != nil {
-		return nil, err
-	}
+func newContractDeployer(handler *ProviderHandler, storage storage) (*ContractDeployer, error) {
+	backend := handler.getBackend()
 
-	chainId, err := provider.ChainID(context.Background())
+	chainId, err := handler.GetChainID(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -58,18 +56,18 @@ func newContractDeployer(provider *ethclient.Client, privateKey string, storage
 		return nil, err
 	}
 
-	helper, err := newContractHelper(common.HexToAddress(factoryAddress), provider, privateKey)
+	helper, err := newContractHelper(common.HexToAddress(factoryAddress), handler)
 	if err != nil {
 		return nil, err
 	}
 
-	factory, err := abi.NewTWFactory(common.HexToAddress(factoryAddress), provider)
+	factory, err := abi.NewTWFactory(common.HexToAddress(factoryAddress), backend)
 	if err != nil {
 		return nil, err
 	}
 
 	contractDeployer := &ContractDeployer{
-		handler,
+		handler.clone(),
 		factory,
 		helper,
 		storage,
@@ -80,21 +78,25 @@ func newContractDeployer(provider *ethclient.Client, privateKey string, storage
 
 // Deploy a new NFT Collection contract.
 //
-// metadata: the contract metadata
+// metadata: the contract metadata, which gets uploaded to IPFS along with its image
 //
 // returns: the address of the deployed contract
 //
 // Example
 //
+//		image, err := os.Open("path/to/image.jpg")
+//		defer image.Close()
+//
 //		address, err := sdk.Deployer.DeployNFTCollection(
 //	     context.Background(),
 //			&thirdweb.DeployNFTCollectionMetadata{
 //				Name: "Go NFT",
+//				Image: image, // Can also be a URL
 //			}
 //		})
-func (deployer *ContractDeployer) DeployNFTCollection(ctx context.Context, metadata *DeployNFTCollectionMetadata) (string, error) {
+func (deployer *ContractDeployer) DeployNFTCollection(ctx context.Context, metadata *DeployNFTCollectionMetadata, options ...*TransactionOptions) (string, error) {
 	metadata.fillDefaults()
-	return deployer.deployContract(ctx, "nft-collection", metadata)
+	return deployer.deployContract(ctx, "nft-collection", metadata, options...)
 }
 
 // Deploy a new Edition contract.
@@ -111,9 +113,9 @@ func (deployer *ContractDeployer) DeployNFTCollection(ctx context.Context, metad
 //				Name: "Go Edition",
 //			}
 //		})
-func (deployer *ContractDeployer) DeployEdition(ctx context.Context, metadata *DeployEditionMetadata) (string, error) {
+func (deployer *ContractDeployer) DeployEdition(ctx context.Context, metadata *DeployEditionMetadata, options ...*TransactionOptions) (string, error) {
 	metadata.fillDefaults()
-	return deployer.deployContract(ctx, "edition", metadata)
+	return deployer.deployContract(ctx, "edition", metadata, options...)
 }
 
 // Deploy a new Token contract.
@@ -130,9 +132,9 @@ func (deployer *ContractDeployer) DeployEdition(ctx context.Context, metadata *D
 //				Name: "Go Token",
 //			}
 //		})
-func (deployer *ContractDeployer) DeployToken(ctx context.Context, metadata *DeployTokenMetadata) (string, error) {
+func (deployer *ContractDeployer) DeployToken(ctx context.Context, metadata *DeployTokenMetadata, options ...*TransactionOptions) (string, error) {
 	metadata.fillDefaults()
-	return deployer.deployContract(ctx, "token", metadata)
+	return deployer.deployContract(ctx, "token", metadata, options...)
 }
 
 // Deploy a new NFT Drop contract.
@@ -149,9 +151,9 @@ func (deployer *ContractDeployer) DeployToken(ctx context.Context, metadata *Dep
 //				Name: "Go NFT Drop",
 //			}
 //		})
-func (deployer *ContractDeployer) DeployNFTDrop(ctx context.Context, metadata *DeployNFTDropMetadata) (string, error) {
+func (deployer *ContractDeployer) DeployNFTDrop(ctx context.Context, metadata *DeployNFTDropMetadata, options ...*TransactionOptions) (string, error) {
 	metadata.fillDefaults()
-	return deployer.deployContract(ctx, "nft-drop", metadata)
+	return deployer.deployContract(ctx, "nft-drop", metadata, options...)
 }
 
 // Deploy a new Edition Drop contract.
@@ -168,9 +170,9 @@ func (deployer *ContractDeployer) DeployNFTDrop(ctx context.Context, metadata *D
 //				Name: "Go Edition Drop",
 //			}
 //		})
-func (deployer *ContractDeployer) DeployEditionDrop(ctx context.Context, metadata *DeployEditionDropMetadata) (string, error) {
+func (deployer *ContractDeployer) DeployEditionDrop(ctx context.Context, metadata *DeployEditionDropMetadata, options ...*TransactionOptions) (string, error) {
 	metadata.fillDefaults()
-	return deployer.deployContract(ctx, "edition-drop", metadata)
+	return deployer.deployContract(ctx, "edition-drop", metadata, options...)
 }
 
 // Deploy a new Multiwrap contract.
@@ -187,9 +189,9 @@ func (deployer *ContractDeployer) DeployEditionDrop(ctx context.Context, metadat
 //				Name: "Go Multiwrap",
 //			}
 //		})
-func (deployer *ContractDeployer) DeployMultiwrap(ctx context.Context, metadata *DeployMultiwrapMetadata) (string, error) {
+func (deployer *ContractDeployer) DeployMultiwrap(ctx context.Context, metadata *DeployMultiwrapMetadata, options ...*TransactionOptions) (string, error) {
 	metadata.fillDefaults()
-	return deployer.deployContract(ctx, "multiwrap", metadata)
+	return deployer.deployContract(ctx, "multiwrap", metadata, options...)
 }
 
 // Deploy a new Marketplace contract.
@@ -206,18 +208,66 @@ func (deployer *ContractDeployer) DeployMultiwrap(ctx context.Context, metadata
 //				Name: "Go Marketplace",
 //			}
 //		})
-func (deployer *ContractDeployer) DeployMarketplace(ctx context.Context, metadata *DeployMarketplaceMetadata) (string, error) {
+func (deployer *ContractDeployer) DeployMarketplace(ctx context.Context, metadata *DeployMarketplaceMetadata, options ...*TransactionOptions) (string, error) {
 	metadata.fillDefaults()
-	return deployer.deployContract(ctx, "marketplace", metadata)
+	return deployer.deployContract(ctx, "marketplace", metadata, options...)
+}
+
+// Deploy a contract directly from its compiler output, without going through the thirdweb factory.
+//
+// output: solc or Hardhat compiler output JSON, see ParseCompilerOutput for the supported formats
+//
+// contractName: the name of the contract to deploy, can be empty if the output only has one contract
+//
+// args: the arguments to pass to the constructor of the contract
+//
+// returns: the address of the deployed contract
+//
+// Example
+//
+//	output, err := ioutil.ReadFile("artifacts/contracts/Greeter.sol/Greeter.json")
+//	address, err := sdk.Deployer.DeployFromCompilerOutput(context.Background(), output, "Greeter", "Hello world!")
+func (deployer *ContractDeployer) DeployFromCompilerOutput(ctx context.Context, output []byte, contractName string, args ...interface{}) (string, error) {
+	compiled, err := ParseCompilerOutput(output, contractName)
+	if err != nil {
+		return "", err
+	}
+
+	contractAbi, err := gethAbi.JSON(strings.NewReader(compiled.Abi))
+	if err != nil {
+		return "", err
+	}
+
+	opts, err := deployer.helper.GetTxOptions(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	address, tx, _, err := bind.DeployContract(opts, contractAbi, compiled.Bytecode, deployer.getBackend(), args...)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := deployer.helper.AwaitTx(ctx, tx.Hash()); err != nil {
+		return "", err
+	}
+
+	return address.String(), nil
 }
 
-func (deployer *ContractDeployer) deployContract(ctx context.Context, contractType string, metadata interface{}) (string, error) {
+func (deployer *ContractDeployer) deployContract(ctx context.Context, contractType string, metadata interface{}, options ...*TransactionOptions) (string, error) {
 	metadataToUpload := map[string]interface{}{}
 	err := mapstructure.Decode(metadata, &metadataToUpload)
 	if err != nil {
 		return "", err
 	}
 
+	// Pass the image through untouched so that files get uploaded along with the contract metadata
+	// and URLs are kept as they are, just like the image of NFT metadata
+	if image := reflect.Indirect(reflect.ValueOf(metadata)).FieldByName("Image"); image.IsValid() && !image.IsNil() {
+		metadataToUpload["image"] = image.Interface()
+	}
+
 	// Set merkle default to {} for drop contracts
 	if _, ok := metadataToUpload["merkle"]; ok {
 		metadataToUpload["merkle"] = map[string]interface{}{}
@@ -254,7 +304,7 @@ func (deployer *ContractDeployer) deployContract(ctx context.Context, contractTy
 
 	encodedFunc, err := contractAbi.Pack("initialize", deployArguments...)
 
-	opts, err := deployer.helper.GetTxOptions(ctx)
+	opts, err := deployer.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return "", err
 	}
@@ -3,13 +3,10 @@ package thirdweb
 import (
 	"context"
 	"fmt"
-	"math/big"
-	"reflect"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -62,50 +59,11 @@ func (encoder *ContractEncoder) Encode(ctx context.Context, signerAddress string
 		return nil, fmt.Errorf("function '%s' not found in contract '%s'", method, encoder.helper.getAddress().String())
 	}
 
-	if len(abiMethod.Inputs) != len(args) {
-		return nil, fmt.Errorf(
-			"function '%s' requires %d arguments, but %d arguments were provided.\nExpected function signature '%s'",
-			method,
-			len(abiMethod.Inputs),
-			len(args),
-			abiMethod.Sig,
-		)
-	}
-
-	// Validate argument input types and convert to proper input types for contract
-	// So we can allow users to pass in string intead of address, int instead of big, etc.
-	typedArgs := []interface{}{}
-	for i, arg := range args {
-		input := abiMethod.Inputs[i]
-		inputType := fmt.Sprint(input.Type)
-
-		if inputType == "address" {
-			parsedArg, ok := arg.(string)
-			if !ok {
-				return nil, fmt.Errorf(
-					"argument %d (%v) should be of type 'string', but type '%v' was provided",
-					i,
-					input.Name,
-					reflect.TypeOf(arg),
-				)
-			}
-
-			arg = common.HexToAddress(parsedArg)
-		} else if strings.Contains(inputType, "int") {
-			parsedArg, ok := arg.(int)
-			if !ok {
-				return nil, fmt.Errorf(
-					"argument %d (%v) should be of type 'int', but type '%v' was provided",
-					i,
-					input.Name,
-					reflect.TypeOf(arg),
-				)
-			}
-
-			arg = big.NewInt(int64(parsedArg))
-		}
-
-		typedArgs = append(typedArgs, arg)
+	// Convert the arguments to the types the ABI encoder expects, so callers can pass a string
+	// instead of an address, an int instead of a big.Int, etc.
+	typedArgs, err := coerceArgs(abiMethod, args, encoder.helper.strictAddressChecksums)
+	if err != nil {
+		return nil, err
 	}
 
 	txOpts, err := encoder.helper.getUnsignedTxOptions(ctx, signerAddress)
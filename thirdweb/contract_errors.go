@@ -0,0 +1,63 @@
+package thirdweb
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Selector of the Error(string) revert reason used by require statements
+var revertReasonSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+// Decode the data of a reverted contract call into a human readable error
+//
+// abiJSON: the ABI of the contract, which should include its custom errors
+//
+// revertData: the raw data returned by the reverted call
+//
+// returns: the decoded error, e.g. "InsufficientBalance(available: 1, required: 2)"
+//
+// Example
+//
+//	message, err := thirdweb.DecodeCustomError(contractAbi, revertData)
+func DecodeCustomError(abiJSON string, revertData []byte) (string, error) {
+	if len(revertData) < 4 {
+		return "", fmt.Errorf("Revert data is too short to contain an error selector")
+	}
+
+	if bytes.Equal(revertData[:4], revertReasonSelector) {
+		return abi.UnpackRevert(revertData)
+	}
+
+	parsedAbi, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return "", err
+	}
+
+	for _, customError := range parsedAbi.Errors {
+		if !bytes.Equal(customError.ID[:4], revertData[:4]) {
+			continue
+		}
+
+		values, err := customError.Inputs.Unpack(revertData[4:])
+		if err != nil {
+			return "", err
+		}
+
+		args := []string{}
+		for i, input := range customError.Inputs {
+			if input.Name != "" {
+				args = append(args, fmt.Sprintf("%s: %v", input.Name, values[i]))
+			} else {
+				args = append(args, fmt.Sprintf("%v", values[i]))
+			}
+		}
+
+		return fmt.Sprintf("%s(%s)", customError.Name, strings.Join(args, ", ")), nil
+	}
+
+	return "", fmt.Errorf("No error in the ABI matches the selector 0x%x", revertData[:4])
+}
@@ -0,0 +1,42 @@
+package thirdweb
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+const customErrorAbi = `[{
+	"type": "error",
+	"name": "InsufficientBalance",
+	"inputs": [
+		{"name": "available", "type": "uint256"},
+		{"name": "required", "type": "uint256"}
+	]
+}]`
+
+func TestDecodeCustomError(t *testing.T) {
+	parsedAbi, _ := abi.JSON(strings.NewReader(customErrorAbi))
+	args, _ := parsedAbi.Errors["InsufficientBalance"].Inputs.Pack(big.NewInt(1), big.NewInt(2))
+	selector := crypto.Keccak256([]byte("InsufficientBalance(uint256,uint256)"))[:4]
+
+	message, err := DecodeCustomError(customErrorAbi, append(selector, args...))
+	assert.Nil(t, err)
+	assert.Equal(t, "InsufficientBalance(available: 1, required: 2)", message)
+
+	_, err = DecodeCustomError(customErrorAbi, []byte{0x01, 0x02, 0x03, 0x04})
+	assert.NotNil(t, err)
+}
+
+func TestDecodeRevertReason(t *testing.T) {
+	stringType, _ := abi.NewType("string", "", nil)
+	args, _ := abi.Arguments{{Type: stringType}}.Pack("Not enough tokens")
+
+	message, err := DecodeCustomError("", append(revertReasonSelector, args...))
+	assert.Nil(t, err)
+	assert.Equal(t, "Not enough tokens", message)
+}
@@ -0,0 +1,199 @@
+package thirdweb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ListenForContractEvents
+//
+// # Listen for events emitted by any contract, resubscribing whenever the subscription drops
+//
+// The events are pushed by the node, so this requires a WebSocket RPC URL. When the subscription
+// errors out, it's retried with an exponential backoff of up to EventReconnectMaxBackoff, and the
+// events emitted while it was down are fetched before listening resumes. This blocks until the
+// context is cancelled.
+//
+// contractAddress: the address of the contract
+//
+// abiJSON: the ABI of the contract, which must contain the events
+//
+// events: the names of the events to listen for
+//
+// handler: called in a new goroutine for each event
+//
+// returns: an error only if listening can't start or be resumed, and nil once the context is cancelled
+//
+// Example
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//
+//	err := sdk.ListenForContractEvents(ctx, "{{contract_address}}", abi, []string{"Transfer"}, func(event *thirdweb.ContractEvent) {
+//		fmt.Println(event.EventName, event.Data)
+//	})
+func (sdk *ThirdwebSDK) ListenForContractEvents(
+	ctx context.Context,
+	contractAddress string,
+	abiJSON string,
+	events []string,
+	handler func(*ContractEvent),
+) error {
+	if !sdk.isValidAddress(contractAddress) {
+		return ErrInvalidAddress
+	}
+	if len(events) == 0 {
+		return fmt.Errorf("At least one event to listen for is required")
+	}
+
+	helper, err := newContractHelper(common.HexToAddress(contractAddress), sdk.ProviderHandler)
+	if err != nil {
+		return err
+	}
+
+	contractEvents, err := newContractEvents(abiJSON, helper)
+	if err != nil {
+		return err
+	}
+
+	eventIds := []common.Hash{}
+	eventNames := map[common.Hash]string{}
+	for _, name := range events {
+		eventAbi, ok := contractEvents.abi.Events[name]
+		if !ok {
+			return fmt.Errorf("Event with name '%s' not found", name)
+		}
+		eventIds = append(eventIds, eventAbi.ID)
+		eventNames[eventAbi.ID] = name
+	}
+
+	listener := &contractEventListener{
+		sdk:    sdk,
+		events: contractEvents,
+		names:  eventNames,
+		query: ethereum.FilterQuery{
+			Addresses: []common.Address{helper.getAddress()},
+			Topics:    [][]common.Hash{eventIds},
+		},
+		handler: handler,
+	}
+
+	return listener.listen(ctx)
+}
+
+type contractEventListener struct {
+	sdk     *ThirdwebSDK
+	events  *ContractEvents
+	names   map[common.Hash]string
+	query   ethereum.FilterQuery
+	handler func(*ContractEvent)
+	// Position of the last event delivered, so the events missed while resubscribing can be fetched
+	// and the ones delivered twice skipped
+	last *types.Log
+}
+
+func (listener *contractEventListener) listen(ctx context.Context) error {
+	backoff := wsReconnectInitialBackoff
+	for {
+		err := listener.subscribe(ctx, func() {
+			backoff = wsReconnectInitialBackoff
+		})
+		if ctx.Err() != nil {
+			return nil
+		}
+		if errors.Is(err, rpc.ErrNotificationsUnsupported) {
+			return fmt.Errorf("Listening for contract events requires a WebSocket RPC URL: %w", err)
+		}
+
+		log.Printf("Contract event subscription lost, retrying in %v, err = %v\n", backoff, err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return nil
+		}
+
+		backoff *= 2
+		if backoff > listener.sdk.eventReconnectMaxBackoff {
+			backoff = listener.sdk.eventReconnectMaxBackoff
+		}
+	}
+}
+
+// Runs a single subscription until it errors out or the context is cancelled
+func (listener *contractEventListener) subscribe(ctx context.Context, onSubscribed func()) error {
+	// The provider is looked up on every attempt, since a dropped WebSocket gets a new one
+	provider := listener.sdk.GetProvider()
+
+	logs := make(chan types.Log)
+	sub, err := provider.SubscribeFilterLogs(ctx, listener.query, logs)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+	onSubscribed()
+
+	// Catch up on the events emitted while there was no subscription. Events that are also pushed
+	// by the new subscription are skipped by their position.
+	if listener.last != nil {
+		query := listener.query
+		query.FromBlock = new(big.Int).SetUint64(listener.last.BlockNumber)
+		missed, err := provider.FilterLogs(ctx, query)
+		if err != nil {
+			return err
+		}
+		for _, eventLog := range missed {
+			listener.deliver(eventLog)
+		}
+	}
+
+	for {
+		select {
+		case eventLog := <-logs:
+			listener.deliver(eventLog)
+		case err := <-sub.Err():
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (listener *contractEventListener) deliver(eventLog types.Log) {
+	// Logs removed by a reorg were already delivered when they were first emitted
+	if eventLog.Removed || len(eventLog.Topics) == 0 {
+		return
+	}
+	if listener.last != nil && !isAfterLog(eventLog, listener.last) {
+		return
+	}
+
+	name, ok := listener.names[eventLog.Topics[0]]
+	if !ok {
+		return
+	}
+
+	event, err := listener.events.transformEvent(name, eventLog)
+	if err != nil {
+		log.Printf("Failed to decode %s event in transaction %s, err = %v\n", name, eventLog.TxHash.Hex(), err)
+		return
+	}
+
+	listener.last = &eventLog
+	go listener.handler(&event)
+}
+
+func isAfterLog(eventLog types.Log, previous *types.Log) bool {
+	if eventLog.BlockNumber != previous.BlockNumber {
+		return eventLog.BlockNumber > previous.BlockNumber
+	}
+	return eventLog.Index > previous.Index
+}
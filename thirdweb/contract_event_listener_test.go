@@ -0,0 +1,17 @@
+package thirdweb
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsAfterLog(t *testing.T) {
+	previous := &types.Log{BlockNumber: 10, Index: 3}
+
+	assert.True(t, isAfterLog(types.Log{BlockNumber: 10, Index: 4}, previous))
+	assert.True(t, isAfterLog(types.Log{BlockNumber: 11, Index: 0}, previous))
+	assert.False(t, isAfterLog(types.Log{BlockNumber: 10, Index: 3}, previous))
+	assert.False(t, isAfterLog(types.Log{BlockNumber: 9, Index: 5}, previous))
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 )
 
 // This interface provides a way to query past events or listen for future events on any contract.
@@ -146,6 +147,65 @@ func (events *ContractEvents) AddEventListener(ctx context.Context, eventName st
 	return subscription
 }
 
+// Subscribe to every event emitted by the contract, as it's emitted. Unlike AddEventListener,
+// this pushes events from the node instead of polling, so it requires a WebSocket RPC URL.
+//
+// sink: the channel to send the events to, logs that don't match an event in the ABI are skipped
+//
+// returns: the subscription, which must be unsubscribed from once you're done
+//
+// Example
+//
+//	events := make(chan thirdweb.ContractEvent)
+//	sub, err := contract.Events.SubscribeToAllEvents(context.Background(), events)
+//	defer sub.Unsubscribe()
+//
+//	for event := range events {
+//		fmt.Println(event.EventName, event.Transaction.BlockNumber, event.Transaction.TxHash, event.Data)
+//	}
+func (events *ContractEvents) SubscribeToAllEvents(ctx context.Context, sink chan<- ContractEvent) (event.Subscription, error) {
+	// No topics matches every event emitted by the contract
+	logs := make(chan types.Log)
+	sub, err := events.helper.GetProvider().SubscribeFilterLogs(ctx, ethereum.FilterQuery{
+		Addresses: []common.Address{events.helper.getAddress()},
+	}, logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				if len(log.Topics) == 0 {
+					continue
+				}
+
+				eventAbi, err := events.abi.EventByID(log.Topics[0])
+				if err != nil {
+					continue
+				}
+
+				contractEvent, err := events.transformEvent(eventAbi.Name, log)
+				if err != nil {
+					return err
+				}
+
+				select {
+				case sink <- contractEvent:
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
 // Query past events of a specific type on the contract.
 //
 // eventName: The name of the event to query for
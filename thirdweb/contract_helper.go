@@ -8,18 +8,24 @@ import (
 	"log"
 	"math/big"
 	"net/http"
+	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/imdario/mergo"
 )
 
 type contractHelper struct {
 	address       common.Address
 	nextOverrides *bind.TransactOpts
+	// Optional ABI of the contract, used to decode custom errors when a transaction reverts
+	contractAbi string
 	*ProviderHandler
 }
 
@@ -28,17 +34,14 @@ const (
 	txMaxAttempts             = 20
 )
 
-func newContractHelper(address common.Address, provider *ethclient.Client, privateKey string) (*contractHelper, error) {
-	if handler, err := NewProviderHandler(provider, privateKey); err != nil {
-		return nil, err
-	} else {
-		helper := &contractHelper{
-			address,
-			nil,
-			handler,
-		}
-		return helper, nil
+func newContractHelper(address common.Address, handler *ProviderHandler) (*contractHelper, error) {
+	helper := &contractHelper{
+		address,
+		nil,
+		"",
+		handler.clone(),
 	}
+	return helper, nil
 }
 
 func (helper *contractHelper) getAddress() common.Address {
@@ -61,7 +64,7 @@ func (helper *contractHelper) OverrideNextTransaction(opts *bind.TransactOpts) {
 	helper.nextOverrides = opts
 }
 
-func (helper *contractHelper) getUnsignedTxOptions(ctx context.Context, signerAddress string) (*bind.TransactOpts, error) {
+func (helper *contractHelper) getUnsignedTxOptions(ctx context.Context, signerAddress string, options ...*TransactionOptions) (*bind.TransactOpts, error) {
 	var tipCap, feeCap *big.Int
 
 	provider := helper.GetProvider()
@@ -89,42 +92,60 @@ func (helper *contractHelper) getUnsignedTxOptions(ctx context.Context, signerAd
 		return nil, err
 	}
 
+	for _, option := range options {
+		applyTransactionOptions(txOpts, option)
+	}
+
 	return txOpts, nil
 }
 
+func applyTransactionOptions(txOpts *bind.TransactOpts, options *TransactionOptions) {
+	if options == nil {
+		return
+	}
+
+	if options.GasLimit != 0 {
+		txOpts.GasLimit = options.GasLimit
+	}
+	if options.GasPrice != nil {
+		// A gas price means a legacy transaction, so we drop the EIP-1559 fee caps
+		txOpts.GasPrice = options.GasPrice
+		txOpts.GasTipCap = nil
+		txOpts.GasFeeCap = nil
+	}
+	if options.MaxFeePerGas != nil {
+		txOpts.GasPrice = nil
+		txOpts.GasFeeCap = options.MaxFeePerGas
+	}
+	if options.MaxPriorityFeePerGas != nil {
+		txOpts.GasPrice = nil
+		txOpts.GasTipCap = options.MaxPriorityFeePerGas
+	}
+	if options.Nonce != nil {
+		txOpts.Nonce = options.Nonce
+	}
+	if options.Value != nil {
+		txOpts.Value = options.Value
+	}
+}
+
 func (helper *contractHelper) getEncodedTxOptions(ctx context.Context) (*bind.TransactOpts, error) {
 	return helper.getRawTxOptions(ctx, true)
 }
 
-func (helper *contractHelper) GetTxOptions(ctx context.Context) (*bind.TransactOpts, error) {
-	return helper.getRawTxOptions(ctx, false)
+func (helper *contractHelper) GetTxOptions(ctx context.Context, options ...*TransactionOptions) (*bind.TransactOpts, error) {
+	return helper.getRawTxOptions(ctx, false, options...)
 }
 
-func (helper *contractHelper) getRawTxOptions(ctx context.Context, noSend bool) (*bind.TransactOpts, error) {
-	if helper.GetRawPrivateKey() == "" {
-		return nil, fmt.Errorf("You need to set a private key to use this function!")
+func (helper *contractHelper) getRawTxOptions(ctx context.Context, noSend bool, options ...*TransactionOptions) (*bind.TransactOpts, error) {
+	if helper.account == nil {
+		return nil, ErrNoSigner
 	}
 
-	var tipCap, feeCap *big.Int
-
-	provider := helper.GetProvider()
-	chainId, err := provider.ChainID(ctx)
+	gasPrice, tipCap, feeCap, err := helper.getGasFees(ctx)
 	if err != nil {
 		return nil, err
 	}
-	block, err := provider.BlockByNumber(ctx, nil)
-
-	if err == nil && block.BaseFee() != nil {
-		// Use gas station to get maxPriorityFeePerGas if we're on polygon
-		if chainId.Cmp(big.NewInt(137)) == 0 {
-			tipCap, _ = helper.getPolygonGasPriorityFee(ctx)
-		} else {
-			tipCap, _ = big.NewInt(0).SetString("2500000000", 10) // maxPriorityFeePerGas
-		}
-
-		baseFee := big.NewInt(0).Mul(block.BaseFee(), big.NewInt(2))
-		feeCap = big.NewInt(0).Add(baseFee, tipCap) // maxFeePerGas
-	}
 
 	signer, err := helper.getSigner(ctx)
 	if err != nil {
@@ -135,6 +156,7 @@ func (helper *contractHelper) getRawTxOptions(ctx context.Context, noSend bool)
 		NoSend:    noSend,
 		From:      helper.GetSignerAddress(),
 		Signer:    signer,
+		GasPrice:  gasPrice,
 		GasTipCap: tipCap, // maxPriorityFeePerGas
 		GasFeeCap: feeCap, // maxFeePerGas
 	}
@@ -144,10 +166,65 @@ func (helper *contractHelper) getRawTxOptions(ctx context.Context, noSend bool)
 		return nil, err
 	}
 
+	for _, option := range options {
+		applyTransactionOptions(txOpts, option)
+	}
+
+	// If no gas limit was given, bind estimates it with eth_estimateGas right before signing, so
+	// we add our safety buffer on top of the estimate at that point
+	if txOpts.GasLimit == 0 && helper.gasLimitMultiplier > 0 && helper.gasLimitMultiplier != 1 {
+		sign := txOpts.Signer
+		multiplier := helper.gasLimitMultiplier
+		txOpts.Signer = func(address common.Address, transaction *types.Transaction) (*types.Transaction, error) {
+			return sign(address, multiplyGasLimit(transaction, multiplier))
+		}
+	}
+
 	return txOpts, nil
 }
 
-func (helper *contractHelper) AwaitTx(ctx context.Context, hash common.Hash) (*types.Transaction, error) {
+func multiplyGasLimit(tx *types.Transaction, multiplier float64) *types.Transaction {
+	gas := uint64(float64(tx.Gas()) * multiplier)
+
+	if tx.Type() == types.DynamicFeeTxType {
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      tx.Nonce(),
+			GasTipCap:  tx.GasTipCap(),
+			GasFeeCap:  tx.GasFeeCap(),
+			Gas:        gas,
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		})
+	}
+
+	return types.NewTx(&types.LegacyTx{
+		Nonce:    tx.Nonce(),
+		GasPrice: tx.GasPrice(),
+		Gas:      gas,
+		To:       tx.To(),
+		Value:    tx.Value(),
+		Data:     tx.Data(),
+	})
+}
+
+func (helper *contractHelper) AwaitTx(ctx context.Context, hash common.Hash) (minedTx *types.Transaction, err error) {
+	// Simulated transactions were never sent, so there is nothing to wait for
+	if simulated, ok := helper.simulatedTxs.LoadAndDelete(hash); ok {
+		return simulated.(*simulatedTx).tx, nil
+	}
+
+	start := time.Now()
+	defer func() {
+		if helper.metrics != nil {
+			helper.metrics.ObserveTransactionConfirmation(time.Since(start), err)
+		}
+	}()
+
+	signedHash := hash
+	hash = helper.getSentTxHash(hash)
 	provider := helper.GetProvider()
 	wait := txWaitTimeBetweenAttempts
 	maxAttempts := uint8(txMaxAttempts)
@@ -172,12 +249,148 @@ func (helper *contractHelper) AwaitTx(ctx context.Context, hash common.Hash) (*t
 				time.Sleep(wait)
 				continue
 			}
+			receipt, err := provider.TransactionReceipt(ctx, hash)
+			if err == nil {
+				// The relayed hash isn't needed once the transaction is mined
+				helper.relayedTxHashes.Delete(signedHash)
+			}
+			if err == nil && receipt.Status == types.ReceiptStatusFailed {
+				return nil, helper.getRevertError(ctx, tx, receipt)
+			}
+
+			if err == nil && helper.blockConfirmations > 0 {
+				confirmed, err := helper.waitForConfirmations(ctx, receipt)
+				if err != nil {
+					return nil, err
+				}
+				if !confirmed {
+					log.Println("Transaction was reorged out, waiting for it to be mined again...")
+					continue
+				}
+			}
+
 			log.Printf("Transaction with hash %v mined successfully\n", tx.Hash())
+			helper.events.emit(EventTransactionConfirmed, map[string]interface{}{
+				"hash": hash.String(),
+			})
 			return tx, nil
 		}
 	}
 }
 
+// Waits until the block of the receipt is followed by the configured number of blocks, and returns
+// false if the transaction is no longer in that block by then because of a reorg
+func (helper *contractHelper) waitForConfirmations(ctx context.Context, receipt *types.Receipt) (bool, error) {
+	provider := helper.GetProvider()
+	target := receipt.BlockNumber.Uint64() + uint64(helper.blockConfirmations)
+
+	for {
+		blockNumber, err := provider.BlockNumber(ctx)
+		if err != nil {
+			return false, err
+		}
+		if blockNumber >= target {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-time.After(txWaitTimeBetweenAttempts):
+		}
+	}
+
+	current, err := provider.TransactionReceipt(ctx, receipt.TxHash)
+	if err != nil {
+		return false, nil
+	}
+
+	return current.BlockHash == receipt.BlockHash, nil
+}
+
+// Returns either a legacy gas price or the EIP-1559 fee caps, depending on whether the chain
+// supports EIP-1559. Only one of gasPrice or (tipCap, feeCap) is ever set.
+func (helper *contractHelper) getGasFees(ctx context.Context) (gasPrice *big.Int, tipCap *big.Int, feeCap *big.Int, err error) {
+	provider := helper.GetProvider()
+
+	// Chains without EIP-1559 (like BSC) either don't implement eth_feeHistory or report no base fee
+	baseFee := big.NewInt(0)
+	if helper.getRpcClient() != nil {
+		feeHistory, err := helper.getFeeHistory(ctx, 1, nil)
+		if err == nil && len(feeHistory.BaseFee) > 0 {
+			baseFee = feeHistory.BaseFee[len(feeHistory.BaseFee)-1]
+		}
+	} else if header, err := provider.HeaderByNumber(ctx, nil); err == nil && header.BaseFee != nil {
+		// Without the raw client, the base fee of the latest block tells us the same thing
+		baseFee = header.BaseFee
+	}
+
+	if baseFee == nil || baseFee.Sign() == 0 {
+		gasPrice, err = provider.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return gasPrice, nil, nil, nil
+	}
+
+	chainId, err := provider.ChainID(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Use gas station to get maxPriorityFeePerGas if we're on polygon
+	if chainId.Cmp(big.NewInt(137)) == 0 {
+		tipCap, _ = helper.getPolygonGasPriorityFee(ctx)
+	} else {
+		tipCap, _ = big.NewInt(0).SetString("2500000000", 10) // maxPriorityFeePerGas
+	}
+
+	doubledBaseFee := big.NewInt(0).Mul(baseFee, big.NewInt(2))
+	feeCap = big.NewInt(0).Add(doubledBaseFee, tipCap) // maxFeePerGas
+
+	return nil, tipCap, feeCap, nil
+}
+
+// Replays a reverted transaction against the state before its block to recover the revert data,
+// since receipts don't include it
+func (helper *contractHelper) getRevertError(ctx context.Context, tx *types.Transaction, receipt *types.Receipt) error {
+	revertError := &ErrTransactionReverted{TxHash: tx.Hash().String()}
+
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return revertError
+	}
+
+	_, err = helper.GetProvider().CallContract(ctx, ethereum.CallMsg{
+		From:  sender,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}, big.NewInt(0).Sub(receipt.BlockNumber, big.NewInt(1)))
+
+	dataErr, ok := err.(rpc.DataError)
+	if !ok {
+		return revertError
+	}
+	revertHex, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return revertError
+	}
+	revertData, err := hexutil.Decode(revertHex)
+	if err != nil {
+		return revertError
+	}
+
+	reason, err := DecodeCustomError(helper.contractAbi, revertData)
+	if err != nil {
+		return revertError
+	}
+
+	revertError.Reason = reason
+	return revertError
+}
+
 func (helper *contractHelper) getPolygonGasPriorityFee(ctx context.Context) (*big.Int, error) {
 	getTipCap := func() (*big.Int, error) {
 		req, err := http.NewRequestWithContext(ctx, "GET", "https://gasstation-mainnet.matic.network/v2", nil)
@@ -213,3 +426,81 @@ func (helper *contractHelper) getPolygonGasPriorityFee(ctx context.Context) (*bi
 
 	return parseUnits(31, 9)
 }
+
+// Sends count transactions from the signer at the same time and waits for all of them to be mined.
+// Each transaction is given its own nonce up front so concurrent sends don't reuse the same one.
+//
+// If some of them fail, the others are still returned, with nil for the failed ones, along with
+// the first error. The nonces of the transactions that failed before being sent are filled with
+// empty transactions, so the nonces after them don't stay stuck behind the gap.
+func (helper *contractHelper) sendParallel(
+	ctx context.Context,
+	count int,
+	send func(ctx context.Context, index int, options *TransactionOptions) (*types.Transaction, error),
+) ([]*types.Transaction, error) {
+	nonce, err := helper.GetProvider().PendingNonceAt(ctx, helper.GetSignerAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*types.Transaction, count)
+	errs := make([]error, count)
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			txs[i], errs[i] = send(ctx, i, &TransactionOptions{
+				Nonce: new(big.Int).SetUint64(nonce + uint64(i)),
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			if fillErr := helper.fillNonceGaps(ctx, nonce+uint64(count)); fillErr != nil {
+				return txs, fmt.Errorf("%w, and failed to fill the skipped nonces: %v", err, fillErr)
+			}
+			return txs, err
+		}
+	}
+
+	return txs, nil
+}
+
+// Sends an empty transaction at every nonce that was skipped before end. Nodes only count the
+// nonces up to the first gap as pending, so the first gap is always the pending nonce.
+func (helper *contractHelper) fillNonceGaps(ctx context.Context, end uint64) error {
+	signerAddress := helper.GetSignerAddress()
+	backend := helper.getBackend()
+	self := bind.NewBoundContract(signerAddress, abi.ABI{}, backend, backend, backend)
+
+	for {
+		nonce, err := helper.GetProvider().PendingNonceAt(ctx, signerAddress)
+		if err != nil {
+			return err
+		}
+		if nonce >= end {
+			return nil
+		}
+
+		// A transfer of nothing to ourselves, with the gas limit of a plain transfer
+		txOpts, err := helper.GetTxOptions(ctx, &TransactionOptions{
+			Nonce:    new(big.Int).SetUint64(nonce),
+			GasLimit: 21000,
+		})
+		if err != nil {
+			return err
+		}
+
+		tx, err := self.Transfer(txOpts)
+		if err != nil {
+			return err
+		}
+
+		if _, err := helper.AwaitTx(ctx, tx.Hash()); err != nil {
+			return err
+		}
+	}
+}
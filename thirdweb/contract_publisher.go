@@ -0,0 +1,264 @@
+package thirdweb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	ethAbi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const contractPublisherAbi = `[{
+	"type": "function",
+	"name": "publishContract",
+	"stateMutability": "nonpayable",
+	"inputs": [
+		{"name": "publisher", "type": "address"},
+		{"name": "contractId", "type": "string"},
+		{"name": "publishMetadataUri", "type": "string"},
+		{"name": "compilerMetadataUri", "type": "string"},
+		{"name": "bytecodeHash", "type": "bytes32"},
+		{"name": "implementation", "type": "address"}
+	],
+	"outputs": []
+}, {
+	"type": "function",
+	"name": "getAllPublishedContracts",
+	"stateMutability": "view",
+	"inputs": [{"name": "publisher", "type": "address"}],
+	"outputs": [{
+		"name": "published",
+		"type": "tuple[]",
+		"components": [
+			{"name": "contractId", "type": "string"},
+			{"name": "publishTimestamp", "type": "uint256"},
+			{"name": "publishMetadataUri", "type": "string"},
+			{"name": "bytecodeHash", "type": "bytes32"},
+			{"name": "implementation", "type": "address"}
+		]
+	}]
+}]`
+
+// Mirrors IContractPublisher.CustomContractInstance so results can be converted from the ABI output
+type customContractInstance struct {
+	ContractId         string
+	PublishTimestamp   *big.Int
+	PublishMetadataUri string
+	BytecodeHash       [32]byte
+	Implementation     common.Address
+}
+
+// The contract publisher lets you publish your own contracts to the thirdweb contract
+// registry, just like publishing from the thirdweb dashboard. Published contracts are
+// registered on Polygon, Mumbai and Goerli, so the SDK needs to be connected to one of them.
+//
+//	sdk, err := thirdweb.NewThirdwebSDK("polygon", &thirdweb.SDKOptions{
+//		PrivateKey: privateKey,
+//	})
+//
+//	uri, err := sdk.Publisher.Publish(context.Background(), bytecode, abi, &thirdweb.PublisherMetadata{
+//		Name:    "MyContract",
+//		Version: "1.0.0",
+//	})
+type ContractPublisher struct {
+	*ProviderHandler
+	abi     ethAbi.ABI
+	storage storage
+}
+
+func newContractPublisher(handler *ProviderHandler, storage storage) (*ContractPublisher, error) {
+	parsedAbi, err := ethAbi.JSON(strings.NewReader(contractPublisherAbi))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContractPublisher{
+		handler.clone(),
+		parsedAbi,
+		storage,
+	}, nil
+}
+
+// Publish a contract to the thirdweb contract registry.
+//
+// compiledBytecode: the compiled bytecode of the contract
+//
+// abiJSON: the ABI of the contract
+//
+// metadata: the publish metadata, the name is used as the ID of the published contract
+//
+// returns: the IPFS URI of the published contract metadata
+//
+// Example
+//
+//	uri, err := sdk.Publisher.Publish(context.Background(), bytecode, abi, &thirdweb.PublisherMetadata{
+//		Name:        "MyContract",
+//		Version:     "1.0.0",
+//		Description: "My custom contract",
+//	})
+func (publisher *ContractPublisher) Publish(ctx context.Context, compiledBytecode []byte, abiJSON string, metadata *PublisherMetadata, options ...*TransactionOptions) (string, error) {
+	if metadata == nil || metadata.Name == "" {
+		return "", errors.New("Publish metadata must include a contract name")
+	}
+
+	if len(compiledBytecode) == 0 {
+		return "", errors.New("Compiled bytecode must not be empty")
+	}
+
+	var parsedAbi interface{}
+	if err := json.Unmarshal([]byte(abiJSON), &parsedAbi); err != nil {
+		return "", err
+	}
+	if _, err := ethAbi.JSON(strings.NewReader(abiJSON)); err != nil {
+		return "", err
+	}
+
+	helper, contract, err := publisher.getContract(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	signerAddress := publisher.GetSignerAddress()
+	bytecode := hexutil.Encode(compiledBytecode)
+
+	bytecodeUri, err := publisher.storage.UploadWithProgress(ctx, strings.NewReader(bytecode), int64(len(bytecode)), nil)
+	if err != nil {
+		return "", err
+	}
+
+	compilerMetadataUri, err := publisher.storage.Upload(
+		ctx,
+		map[string]interface{}{
+			"name": metadata.Name,
+			"abi":  parsedAbi,
+		},
+		helper.getAddress().String(),
+		signerAddress.String(),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	tags := metadata.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	publishMetadata := map[string]interface{}{
+		"name":        metadata.Name,
+		"version":     metadata.Version,
+		"description": metadata.Description,
+		"readme":      metadata.Readme,
+		"license":     metadata.License,
+		"tags":        tags,
+		"publisher":   signerAddress.String(),
+		"metadataUri": compilerMetadataUri,
+		"bytecodeUri": bytecodeUri,
+	}
+
+	publishMetadataUri, err := publisher.storage.Upload(ctx, publishMetadata, helper.getAddress().String(), signerAddress.String())
+	if err != nil {
+		return "", err
+	}
+
+	txOpts, err := helper.GetTxOptions(ctx, options...)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := contract.Transact(
+		txOpts,
+		"publishContract",
+		signerAddress,
+		metadata.Name,
+		publishMetadataUri,
+		compilerMetadataUri,
+		crypto.Keccak256Hash(compiledBytecode),
+		common.HexToAddress(zeroAddress),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := helper.AwaitTx(ctx, tx.Hash()); err != nil {
+		return "", err
+	}
+
+	return publishMetadataUri, nil
+}
+
+// Get all the contracts published by an address.
+//
+// publisherAddress: the address of the publisher
+//
+// returns: the latest version of each contract the address has published
+//
+// Example
+//
+//	contracts, err := sdk.Publisher.GetPublished(context.Background(), "{{wallet_address}}")
+//	for _, contract := range contracts {
+//		fmt.Println(contract.Id, contract.MetadataUri)
+//	}
+func (publisher *ContractPublisher) GetPublished(ctx context.Context, publisherAddress string) ([]*PublishedContract, error) {
+	if !publisher.isValidAddress(publisherAddress) {
+		return nil, ErrInvalidAddress
+	}
+
+	_, contract, err := publisher.getContract(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []interface{}
+	err = contract.Call(&bind.CallOpts{Context: ctx}, &out, "getAllPublishedContracts", common.HexToAddress(publisherAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	instances := *ethAbi.ConvertType(out[0], new([]customContractInstance)).(*[]customContractInstance)
+
+	published := []*PublishedContract{}
+	for _, instance := range instances {
+		published = append(published, &PublishedContract{
+			Id:                    instance.ContractId,
+			PublishTimestamp:      time.Unix(instance.PublishTimestamp.Int64(), 0),
+			MetadataUri:           instance.PublishMetadataUri,
+			BytecodeHash:          common.Hash(instance.BytecodeHash).Hex(),
+			ImplementationAddress: instance.Implementation.String(),
+		})
+	}
+
+	return published, nil
+}
+
+func (publisher *ContractPublisher) getContract(ctx context.Context) (*contractHelper, *bind.BoundContract, error) {
+	chainId, err := publisher.GetChainID(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	address, err := getContractAddressByChainId(ChainID(chainId.Int64()), "ContractPublisher")
+	if err != nil {
+		return nil, nil, err
+	}
+	if address == zeroAddress {
+		return nil, nil, errors.New("Contract publishing is not supported on this chain")
+	}
+
+	helper, err := newContractHelper(common.HexToAddress(address), publisher.ProviderHandler)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	backend := publisher.getBackend()
+	contract := bind.NewBoundContract(common.HexToAddress(address), publisher.abi, backend, backend, backend)
+
+	return helper, contract, nil
+}
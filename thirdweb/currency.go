@@ -0,0 +1,75 @@
+package thirdweb
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// FormatCurrencyValue
+//
+// # Convert a raw token amount into a currency value with its human readable display value
+//
+// amount: the raw amount in the smallest unit of the currency (wei for the native token)
+//
+// decimals: the number of decimals of the currency
+//
+// symbol: the symbol of the currency
+//
+// returns: the currency value, with the name left empty
+//
+// Example
+//
+//	value := thirdweb.FormatCurrencyValue(big.NewInt(1500000000000000000), 18, "ETH")
+//	fmt.Println(value.String(), value.Symbol) // 1.5 ETH
+func FormatCurrencyValue(amount *big.Int, decimals int, symbol string) *CurrencyValue {
+	return &CurrencyValue{
+		Symbol:       symbol,
+		Decimals:     decimals,
+		Value:        amount,
+		DisplayValue: formatUnits(amount, decimals),
+	}
+}
+
+// ParseCurrencyValue
+//
+// # Convert a human readable amount into the raw amount in the smallest unit of the currency
+//
+// Unlike converting from a float64, the amount is parsed exactly, so no precision is lost for
+// currencies with many decimals.
+//
+// display: the human readable amount, e.g. "1.5"
+//
+// decimals: the number of decimals of the currency
+//
+// returns: the raw amount, or an error if the amount is negative or has more decimals than the currency
+//
+// Example
+//
+//	amount, err := thirdweb.ParseCurrencyValue("1.5", 18)
+//	fmt.Println(amount) // 1500000000000000000
+func ParseCurrencyValue(display string, decimals int) (*big.Int, error) {
+	value, err := decimal.NewFromString(display)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid currency value '%s': %w", display, err)
+	}
+	if value.IsNegative() {
+		return nil, fmt.Errorf("Currency value '%s' can't be negative", display)
+	}
+
+	shifted := value.Shift(int32(decimals))
+	if !shifted.Equal(shifted.Truncate(0)) {
+		return nil, fmt.Errorf("Currency value '%s' has more than %d decimals", display, decimals)
+	}
+
+	return shifted.BigInt(), nil
+}
+
+// Returns the exact display value, which unlike DisplayValue doesn't lose precision for large amounts
+func (value *CurrencyValue) String() string {
+	if value.Value == nil {
+		return "0"
+	}
+	return decimal.NewFromBigInt(value.Value, -int32(value.Decimals)).String()
+}
@@ -0,0 +1,41 @@
+package thirdweb
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatCurrencyValue(t *testing.T) {
+	amount, _ := new(big.Int).SetString("1500000000000000000", 10)
+	value := FormatCurrencyValue(amount, 18, "ETH")
+
+	assert.Equal(t, "ETH", value.Symbol)
+	assert.Equal(t, 18, value.Decimals)
+	assert.Equal(t, float64(1.5), value.DisplayValue)
+	assert.Equal(t, "1.5", value.String())
+
+	// Beyond float64 precision
+	amount, _ = new(big.Int).SetString("123456789123456789123456789", 10)
+	assert.Equal(t, "123456789.123456789123456789", FormatCurrencyValue(amount, 18, "ETH").String())
+}
+
+func TestParseCurrencyValue(t *testing.T) {
+	amount, err := ParseCurrencyValue("1.5", 18)
+	assert.Nil(t, err)
+	assert.Equal(t, "1500000000000000000", amount.String())
+
+	amount, err = ParseCurrencyValue("123456789.123456789123456789", 18)
+	assert.Nil(t, err)
+	assert.Equal(t, "123456789123456789123456789", amount.String())
+
+	_, err = ParseCurrencyValue("1.2345", 2)
+	assert.NotNil(t, err)
+
+	_, err = ParseCurrencyValue("-1", 18)
+	assert.NotNil(t, err)
+
+	_, err = ParseCurrencyValue("abc", 18)
+	assert.NotNil(t, err)
+}
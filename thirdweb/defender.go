@@ -0,0 +1,251 @@
+package thirdweb
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	ethAbi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	signerTypes "github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const defenderForwarderAbi = `[{
+	"type": "function",
+	"name": "getNonce",
+	"stateMutability": "view",
+	"inputs": [{"name": "from", "type": "address"}],
+	"outputs": [{"name": "", "type": "uint256"}]
+}]`
+
+const (
+	// EIP-712 domain of the OpenZeppelin MinimalForwarder
+	defenderForwarderDomainName    = "GSNv2 Forwarder"
+	defenderForwarderDomainVersion = "0.0.1"
+)
+
+// Sends transactions through an OpenZeppelin Defender relayer. The call is wrapped in an ERC-2771
+// forward request signed by the SDK wallet, and the relayer submits it to the trusted forwarder,
+// which appends the address of the SDK wallet to the calldata so the contract sees it as the sender.
+type defenderBroadcaster struct {
+	handler    *ProviderHandler
+	options    *OpenZeppelinOptions
+	httpClient *http.Client
+}
+
+type defenderForwardRequest struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Value string `json:"value"`
+	Gas   string `json:"gas"`
+	Nonce string `json:"nonce"`
+	Data  string `json:"data"`
+}
+
+type defenderRelayResponse struct {
+	Status string `json:"status"`
+	// The result of the relayer, JSON encoded
+	Result  string `json:"result"`
+	Message string `json:"message"`
+}
+
+type defenderRelayResult struct {
+	TxHash string `json:"txHash"`
+}
+
+func newDefenderBroadcaster(handler *ProviderHandler, options *OpenZeppelinOptions, httpClient *http.Client) *defenderBroadcaster {
+	return &defenderBroadcaster{
+		handler:    handler,
+		options:    options,
+		httpClient: httpClient,
+	}
+}
+
+func (broadcaster *defenderBroadcaster) sponsorsGas() {}
+
+func (broadcaster *defenderBroadcaster) Send(ctx context.Context, tx *types.Transaction) (common.Hash, error) {
+	if tx.To() == nil {
+		return common.Hash{}, fmt.Errorf("OpenZeppelin Defender can't relay contract deployments")
+	}
+
+	privateKey := broadcaster.handler.GetPrivateKey()
+	if privateKey == nil {
+		return common.Hash{}, &noSignerError{typeName: "defender"}
+	}
+
+	chainId, err := broadcaster.handler.GetChainID(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	from := broadcaster.handler.GetSignerAddress()
+	forwarder := common.HexToAddress(ozDefenderForwarderAddress)
+	if broadcaster.options.RelayerForwarderAddress != "" {
+		forwarder = common.HexToAddress(broadcaster.options.RelayerForwarderAddress)
+	}
+	nonce, err := broadcaster.getNonce(ctx, forwarder, from)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	request := &defenderForwardRequest{
+		From:  from.Hex(),
+		To:    tx.To().Hex(),
+		Value: tx.Value().String(),
+		Gas:   fmt.Sprintf("%v", tx.Gas()),
+		Nonce: nonce.String(),
+		Data:  hexutil.Encode(tx.Data()),
+	}
+
+	typedData := generateDefenderMessage(chainId, forwarder.Hex(), request, tx.Data())
+
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
+	sigHash := crypto.Keccak256(rawData)
+
+	signatureHash, err := crypto.Sign(sigHash, privateKey)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	// We need this to correct v = 0,1 to v = 27,28 - or else all will break
+	if signatureHash[64] == 0 || signatureHash[64] == 1 {
+		signatureHash[64] += 27
+	}
+
+	return broadcaster.relay(ctx, map[string]interface{}{
+		"request":          request,
+		"signature":        "0x" + hex.EncodeToString(signatureHash),
+		"forwarderAddress": forwarder.Hex(),
+		"type":             "forward",
+	})
+}
+
+func (broadcaster *defenderBroadcaster) getNonce(ctx context.Context, forwarder common.Address, from common.Address) (*big.Int, error) {
+	forwarderAbi, err := ethAbi.JSON(strings.NewReader(defenderForwarderAbi))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := forwarderAbi.Pack("getNonce", from)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := broadcaster.handler.GetProvider().CallContract(ctx, ethereum.CallMsg{To: &forwarder, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := forwarderAbi.Unpack("getNonce", result)
+	if err != nil {
+		return nil, err
+	}
+
+	return values[0].(*big.Int), nil
+}
+
+func (broadcaster *defenderBroadcaster) relay(ctx context.Context, payload interface{}) (common.Hash, error) {
+	reqBody, err := json.Marshal(payload)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, broadcaster.options.RelayerUrl, bytes.NewReader(reqBody))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if broadcaster.options.RelayAPIKey != "" {
+		req.SetBasicAuth(broadcaster.options.RelayAPIKey, broadcaster.options.RelaySecretKey)
+	}
+
+	res, err := broadcaster.httpClient.Do(req)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return common.Hash{}, fmt.Errorf("OpenZeppelin Defender relay request failed with status code %d: %s", res.StatusCode, string(body))
+	}
+
+	response := &defenderRelayResponse{}
+	if err := json.Unmarshal(body, response); err != nil {
+		return common.Hash{}, &unmarshalError{body: string(body), typeName: "defenderRelayResponse", UnderlyingError: err}
+	}
+	if response.Status != "success" {
+		return common.Hash{}, fmt.Errorf("OpenZeppelin Defender failed to relay the transaction: %s", response.Message)
+	}
+
+	result := &defenderRelayResult{}
+	if err := json.Unmarshal([]byte(response.Result), result); err != nil {
+		return common.Hash{}, &unmarshalError{body: response.Result, typeName: "defenderRelayResult", UnderlyingError: err}
+	}
+	if result.TxHash == "" {
+		return common.Hash{}, fmt.Errorf("OpenZeppelin Defender didn't return a transaction hash: %s", response.Result)
+	}
+
+	return common.HexToHash(result.TxHash), nil
+}
+
+func generateDefenderMessage(chainId *big.Int, forwarderAddress string, request *defenderForwardRequest, data []byte) *signerTypes.TypedData {
+	return &signerTypes.TypedData{
+		Types: signerTypes.Types{
+			"ForwardRequest": []signerTypes.Type{
+				{Name: "from", Type: "address"},
+				{Name: "to", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "gas", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "data", Type: "bytes"},
+			},
+			"EIP712Domain": []signerTypes.Type{
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+		},
+		PrimaryType: "ForwardRequest",
+		Domain: signerTypes.TypedDataDomain{
+			Name:              defenderForwarderDomainName,
+			Version:           defenderForwarderDomainVersion,
+			ChainId:           math.NewHexOrDecimal256(chainId.Int64()),
+			VerifyingContract: forwarderAddress,
+		},
+		Message: signerTypes.TypedDataMessage{
+			"from":  request.From,
+			"to":    request.To,
+			"value": request.Value,
+			"gas":   request.Gas,
+			"nonce": request.Nonce,
+			"data":  data,
+		},
+	}
+}
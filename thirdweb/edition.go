@@ -5,7 +5,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 
 	"github.com/thirdweb-dev/go-sdk/v2/abi"
 )
@@ -32,19 +31,21 @@ type Edition struct {
 	Events    *ContractEvents
 }
 
-func newEdition(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*Edition, error) {
-	if contractAbi, err := abi.NewTokenERC1155(address, provider); err != nil {
+func newEdition(handler *ProviderHandler, address common.Address, storage storage) (*Edition, error) {
+	backend := handler.getBackend()
+
+	if contractAbi, err := abi.NewTokenERC1155(address, backend); err != nil {
 		return nil, err
 	} else {
-		if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+		if helper, err := newContractHelper(address, handler); err != nil {
 			return nil, err
 		} else {
-			erc1155, err := newERC1155Standard(provider, address, privateKey, storage)
+			erc1155, err := newERC1155Standard(handler, address, storage)
 			if err != nil {
 				return nil, err
 			}
 
-			signature, err := newERC1155SignatureMinting(provider, address, privateKey, storage)
+			signature, err := newERC1155SignatureMinting(handler, address, storage)
 			if err != nil {
 				return nil, err
 			}
@@ -77,8 +78,8 @@ func newEdition(provider *ethclient.Client, address common.Address, privateKey s
 // metadataWithSupply: nft metadata with supply of the NFT to mint
 //
 // returns: the transaction receipt of the mint
-func (edition *Edition) Mint(ctx context.Context, metadataWithSupply *EditionMetadataInput) (*types.Transaction, error) {
-	return edition.erc1155.Mint(ctx, metadataWithSupply)
+func (edition *Edition) Mint(ctx context.Context, metadataWithSupply *EditionMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
+	return edition.erc1155.Mint(ctx, metadataWithSupply, options...)
 }
 
 // Mint a new NFT to the specified wallet.
@@ -105,8 +106,8 @@ func (edition *Edition) Mint(ctx context.Context, metadataWithSupply *EditionMet
 //		}
 //
 //		tx, err := contract.MintTo(context.Background(), "{{wallet_address}}", metadataWithSupply)
-func (edition *Edition) MintTo(ctx context.Context, address string, metadataWithSupply *EditionMetadataInput) (*types.Transaction, error) {
-	return edition.erc1155.MintTo(ctx, address, metadataWithSupply)
+func (edition *Edition) MintTo(ctx context.Context, address string, metadataWithSupply *EditionMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
+	return edition.erc1155.MintTo(ctx, address, metadataWithSupply, options...)
 }
 
 // Mint additionaly supply of a token to the connected wallet.
@@ -116,8 +117,8 @@ func (edition *Edition) MintTo(ctx context.Context, address string, metadataWith
 // additionalSupply: additional supply to mint
 //
 // returns: the transaction receipt of the mint
-func (edition *Edition) MintAdditionalSupply(ctx context.Context, tokenId int, additionalSupply int) (*types.Transaction, error) {
-	return edition.erc1155.MintAdditionalSupply(ctx, tokenId, additionalSupply)
+func (edition *Edition) MintAdditionalSupply(ctx context.Context, tokenId int, additionalSupply int, options ...*TransactionOptions) (*types.Transaction, error) {
+	return edition.erc1155.MintAdditionalSupply(ctx, tokenId, additionalSupply, options...)
 }
 
 // Mint additional supply of a token to the specified wallet.
@@ -129,8 +130,8 @@ func (edition *Edition) MintAdditionalSupply(ctx context.Context, tokenId int, a
 // additionalySupply: additional supply to mint
 //
 // returns: the transaction receipt of the mint
-func (edition *Edition) MintAdditionalSupplyTo(ctx context.Context, to string, tokenId int, additionalSupply int) (*types.Transaction, error) {
-	return edition.erc1155.MintAdditionalSupplyTo(ctx, to, tokenId, additionalSupply)
+func (edition *Edition) MintAdditionalSupplyTo(ctx context.Context, to string, tokenId int, additionalSupply int, options ...*TransactionOptions) (*types.Transaction, error) {
+	return edition.erc1155.MintAdditionalSupplyTo(ctx, to, tokenId, additionalSupply, options...)
 }
 
 // Mint a batch of NFTs to the connected wallet.
@@ -138,8 +139,8 @@ func (edition *Edition) MintAdditionalSupplyTo(ctx context.Context, to string, t
 // metadatasWithSupply: list of NFT metadatas with supplies to mint
 //
 // returns: the transaction receipt of the mint
-func (edition *Edition) MintBatch(ctx context.Context, metadatasWithSupply []*EditionMetadataInput) (*types.Transaction, error) {
-	return edition.erc1155.MintBatch(ctx, metadatasWithSupply)
+func (edition *Edition) MintBatch(ctx context.Context, metadatasWithSupply []*EditionMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
+	return edition.erc1155.MintBatch(ctx, metadatasWithSupply, options...)
 }
 
 // Mint a batch of NFTs to a specific wallet.
@@ -170,6 +171,6 @@ func (edition *Edition) MintBatch(ctx context.Context, metadatasWithSupply []*Ed
 //	}
 //
 //	tx, err := contract.MintBatchTo(context.Background(), "{{wallet_address}}", metadatasWithSupply)
-func (edition *Edition) MintBatchTo(ctx context.Context, to string, metadatasWithSupply []*EditionMetadataInput) (*types.Transaction, error) {
-	return edition.erc1155.MintBatchTo(ctx, to, metadatasWithSupply)
+func (edition *Edition) MintBatchTo(ctx context.Context, to string, metadatasWithSupply []*EditionMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
+	return edition.erc1155.MintBatchTo(ctx, to, metadatasWithSupply, options...)
 }
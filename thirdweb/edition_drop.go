@@ -2,10 +2,10 @@ package thirdweb
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 
 	"github.com/thirdweb-dev/go-sdk/v2/abi"
 )
@@ -32,17 +32,19 @@ type EditionDrop struct {
 	Events          *ContractEvents
 }
 
-func newEditionDrop(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*EditionDrop, error) {
-	if contractAbi, err := abi.NewDropERC1155(address, provider); err != nil {
+func newEditionDrop(handler *ProviderHandler, address common.Address, storage storage) (*EditionDrop, error) {
+	backend := handler.getBackend()
+
+	if contractAbi, err := abi.NewDropERC1155(address, backend); err != nil {
 		return nil, err
 	} else {
-		if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+		if helper, err := newContractHelper(address, handler); err != nil {
 			return nil, err
 		} else {
-			if erc1155, err := newERC1155Standard(provider, address, privateKey, storage); err != nil {
+			if erc1155, err := newERC1155Standard(handler, address, storage); err != nil {
 				return nil, err
 			} else {
-				claimConditions, err := newEditionDropClaimConditions(address, provider, helper, storage)
+				claimConditions, err := newEditionDropClaimConditions(address, backend, helper, storage)
 				if err != nil {
 					return nil, err
 				}
@@ -105,8 +107,8 @@ func newEditionDrop(provider *ethclient.Client, address common.Address, privateK
 //	}
 //
 //	tx, err := contract.MintBatchTo(context.Background(), "{{wallet_address}}", metadatasWithSupply)
-func (drop *EditionDrop) CreateBatch(ctx context.Context, metadatas []*NFTMetadataInput) (*types.Transaction, error) {
-	return drop.erc1155.CreateBatch(ctx, metadatas)
+func (drop *EditionDrop) CreateBatch(ctx context.Context, metadatas []*NFTMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
+	return drop.erc1155.CreateBatch(ctx, metadatas, options...)
 }
 
 // Claim NFTs from this contract to the connect wallet.
@@ -116,8 +118,8 @@ func (drop *EditionDrop) CreateBatch(ctx context.Context, metadatas []*NFTMetada
 // quantity: the number of NFTs to claim
 //
 // returns: the transaction receipt of the claim
-func (drop *EditionDrop) Claim(ctx context.Context, tokenId int, quantity int) (*types.Transaction, error) {
-	return drop.erc1155.Claim(ctx, tokenId, quantity)
+func (drop *EditionDrop) Claim(ctx context.Context, tokenId int, quantity int, options ...*TransactionOptions) (*types.Transaction, error) {
+	return drop.erc1155.Claim(ctx, tokenId, quantity, options...)
 }
 
 // Claim NFTs from this contract to the connect wallet.
@@ -137,6 +139,49 @@ func (drop *EditionDrop) Claim(ctx context.Context, tokenId int, quantity int) (
 //	quantity = 1
 //
 //	tx, err := contract.ClaimTo(context.Background(), address, tokenId, quantity)
-func (drop *EditionDrop) ClaimTo(ctx context.Context, destinationAddress string, tokenId int, quantity int) (*types.Transaction, error) {
-	return drop.erc1155.ClaimTo(ctx, destinationAddress, tokenId, quantity)
-}
\ No newline at end of file
+func (drop *EditionDrop) ClaimTo(ctx context.Context, destinationAddress string, tokenId int, quantity int, options ...*TransactionOptions) (*types.Transaction, error) {
+	return drop.erc1155.ClaimTo(ctx, destinationAddress, tokenId, quantity, options...)
+}
+
+// Get a single claim condition of a token, without fetching the others.
+//
+// tokenId: the token ID of the token to get the claim condition for
+//
+// conditionId: the index of the claim condition among the current claim conditions of the token
+//
+// returns: the claim condition metadata
+//
+// Example
+//
+//	condition, err := contract.GetClaimConditionById(context.Background(), 0, 1)
+func (drop *EditionDrop) GetClaimConditionById(ctx context.Context, tokenId int, conditionId int) (*ClaimConditionOutput, error) {
+	return drop.ClaimConditions.Get(ctx, tokenId, conditionId)
+}
+
+// Get the maximum number of NFTs that can be claimed across all token IDs.
+//
+// returns: the sum of the supply caps of every token ID, or nil if any token's supply isn't capped
+func (drop *EditionDrop) GetMaxTotalSupply(ctx context.Context) (*big.Int, error) {
+	return drop.erc1155.GetMaxTotalSupply(ctx)
+}
+
+// Check if a wallet can claim NFTs from this contract.
+//
+// tokenId: the token ID of the NFT to claim
+//
+// quantity: the number of NFTs to claim
+//
+// address: the address of the wallet that would claim the NFTs
+//
+// returns: true if the wallet can claim, otherwise false and an error explaining why it can't
+//
+// Example
+//
+//	address = "{{wallet_address}}"
+//	tokenId = 0
+//	quantity = 1
+//
+//	canClaim, err := contract.CanClaim(context.Background(), tokenId, quantity, address)
+func (drop *EditionDrop) CanClaim(ctx context.Context, tokenId int, quantity int, address string) (bool, error) {
+	return drop.erc1155.CanClaim(ctx, tokenId, quantity, address)
+}
@@ -3,11 +3,11 @@ package thirdweb
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 
 	"github.com/thirdweb-dev/go-sdk/v2/abi"
 )
@@ -20,8 +20,8 @@ type EditionDropClaimConditions struct {
 	storage storage
 }
 
-func newEditionDropClaimConditions(address common.Address, provider *ethclient.Client, helper *contractHelper, storage storage) (*EditionDropClaimConditions, error) {
-	if contractAbi, err := abi.NewDropERC1155(address, provider); err != nil {
+func newEditionDropClaimConditions(address common.Address, backend bind.ContractBackend, helper *contractHelper, storage storage) (*EditionDropClaimConditions, error) {
+	if contractAbi, err := abi.NewDropERC1155(address, backend); err != nil {
 		return nil, err
 	} else {
 		claimConditions := &EditionDropClaimConditions{
@@ -75,6 +75,39 @@ func (claim *EditionDropClaimConditions) GetActive(ctx context.Context, tokenId
 	return claimCondition, nil
 }
 
+// Get a single claim condition of a token, without fetching the others
+//
+// tokenId: the token ID of the token to get the claim condition for
+//
+// conditionId: the index of the claim condition among the current claim conditions of the token
+//
+// returns: the claim condition metadata
+//
+// Example
+//
+//	tokenId := 0
+//	condition, err := contract.ClaimConditions.Get(context.Background(), tokenId, 1)
+//	fmt.Println("Start Time:", condition.StartTime)
+func (claim *EditionDropClaimConditions) Get(ctx context.Context, tokenId int, conditionId int) (*ClaimConditionOutput, error) {
+	condition, err := claim.abi.ClaimCondition(&bind.CallOpts{Context: ctx}, big.NewInt(int64(tokenId)))
+	if err != nil {
+		return nil, err
+	}
+
+	if conditionId < 0 || int64(conditionId) >= condition.Count.Int64() {
+		return nil, fmt.Errorf("Token %d has %d claim conditions, condition %d does not exist", tokenId, condition.Count.Int64(), conditionId)
+	}
+
+	// Claim condition IDs keep counting up when conditions are replaced, so offset by the first current one
+	id := big.NewInt(0).Add(condition.CurrentStartId, big.NewInt(int64(conditionId)))
+	mc, err := claim.abi.GetClaimConditionById(&bind.CallOpts{Context: ctx}, big.NewInt(int64(tokenId)), id)
+	if err != nil {
+		return nil, err
+	}
+
+	return transformResultToClaimCondition(ctx, &mc, claim.helper.GetProvider())
+}
+
 // Get all claim conditions on this contract for a given token
 //
 // tokenId: the token ID of the token to get the claim conditions for
@@ -2,7 +2,11 @@ package thirdweb
 
 import (
 	"context"
+	"errors"
+	"math/big"
+	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -118,6 +122,26 @@ func TestTransferEdition(t *testing.T) {
 	assert.Equal(t, 0, balance)
 }
 
+func TestMintAdditionalSupplyEdition(t *testing.T) {
+	edition := getEdition()
+
+	edition.Mint(context.Background(), &EditionMetadataInput{
+		Metadata: &NFTMetadataInput{
+			Name: "NFT",
+		},
+		Supply: 10,
+	})
+
+	_, err := edition.MintAdditionalSupply(context.Background(), 0, 5)
+	assert.Nil(t, err)
+
+	balance, _ := edition.Balance(context.Background(), 0)
+	assert.Equal(t, 15, balance)
+
+	_, err = edition.MintAdditionalSupply(context.Background(), 1, 5)
+	assert.NotNil(t, err)
+}
+
 func TestSignatureMint(t *testing.T) {
 	edition := getEdition()
 
@@ -150,3 +174,77 @@ func TestSignatureMint(t *testing.T) {
 	balance, _ := edition.Balance(context.Background(), 0)
 	assert.Equal(t, 1, balance)
 }
+
+func TestFetchEditionsByTokenIdOrdering(t *testing.T) {
+	tests := []struct {
+		name  string
+		count int
+	}{
+		{"empty", 0},
+		{"single", 1},
+		{"small", 10},
+		{"large", 100},
+	}
+
+	// Respond after a random delay so the results come back out of order
+	fetch := func(ctx context.Context, tokenId int) (*EditionMetadata, error) {
+		time.Sleep(time.Duration(rand.Intn(5)) * time.Millisecond)
+		return &EditionMetadata{
+			Metadata: &NFTMetadata{Id: big.NewInt(int64(tokenId))},
+			Supply:   1,
+		}, nil
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tokenIds := []*big.Int{}
+			for i := 0; i < test.count; i++ {
+				tokenIds = append(tokenIds, big.NewInt(int64(i)))
+			}
+
+			editions, err := fetchEditionsByTokenId(context.Background(), newConcurrencyLimiter(5), time.Second, fetch, tokenIds)
+			assert.Nil(t, err)
+			assert.Equal(t, test.count, len(editions))
+			for i, edition := range editions {
+				assert.Equal(t, int64(i), edition.Metadata.Id.Int64())
+			}
+		})
+	}
+}
+
+type hangingStorage struct {
+	mockStorage
+}
+
+func (storage *hangingStorage) Get(ctx context.Context, uri string) ([]byte, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestFetchEditionsByTokenIdTimeout(t *testing.T) {
+	// Token 1 hangs until its fetch times out, the others resolve right away
+	fetch := func(ctx context.Context, tokenId int) (*EditionMetadata, error) {
+		if tokenId == 1 {
+			metadata, err := fetchTokenMetadata(ctx, tokenId, "ipfs://hanging/1", &hangingStorage{})
+			return &EditionMetadata{Metadata: metadata}, err
+		}
+		return &EditionMetadata{Metadata: &NFTMetadata{Id: big.NewInt(int64(tokenId))}}, nil
+	}
+
+	tokenIds := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(2)}
+	editions, err := fetchEditionsByTokenId(context.Background(), nil, 10*time.Millisecond, fetch, tokenIds)
+	assert.Nil(t, editions)
+	var timeoutErr *ErrGatewayTimeout
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, "ipfs://hanging/1", timeoutErr.URL)
+}
+
+func TestFetchTokenMetadataTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := fetchTokenMetadata(ctx, 0, "ipfs://hanging/0", &hangingStorage{})
+	var timeoutErr *ErrGatewayTimeout
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, "ipfs://hanging/0", timeoutErr.URL)
+}
@@ -0,0 +1,262 @@
+package thirdweb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Client for a thirdweb Engine instance, which manages backend wallets and transactions on behalf
+// of the SDK
+type engineClient struct {
+	url           string
+	accessToken   string
+	backendWallet string
+	httpClient    *http.Client
+}
+
+type engineQueueResult struct {
+	QueueId string `json:"queueId"`
+}
+
+type engineTransactionStatus struct {
+	Status          string `json:"status"`
+	TransactionHash string `json:"transactionHash"`
+	ErrorMessage    string `json:"errorMessage"`
+}
+
+type engineWebhook struct {
+	Id        int    `json:"id"`
+	Url       string `json:"url"`
+	EventType string `json:"eventType"`
+	Secret    string `json:"secret"`
+}
+
+type engineErrorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Maps the SDK transaction event names to the webhook event types understood by Engine
+var engineWebhookEventTypes = map[string]string{
+	"transaction.queued": "queued_transaction",
+	"transaction.sent":   "sent_transaction",
+	"transaction.mined":  "mined_transaction",
+	"transaction.failed": "errored_transaction",
+}
+
+func newEngineClient(url string, accessToken string, backendWallet string, httpClient *http.Client) *engineClient {
+	return &engineClient{
+		url:           strings.TrimSuffix(url, "/"),
+		accessToken:   accessToken,
+		backendWallet: backendWallet,
+		httpClient:    httpClient,
+	}
+}
+
+func (engine *engineClient) createWebhook(ctx context.Context, url string, event string) (*engineWebhook, error) {
+	eventType, ok := engineWebhookEventTypes[event]
+	if !ok {
+		return nil, fmt.Errorf("Unsupported webhook event %s", event)
+	}
+
+	result := &engineWebhook{}
+	if err := engine.post(ctx, "/webhooks/create", map[string]interface{}{
+		"url":       url,
+		"eventType": eventType,
+	}, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (engine *engineClient) revokeWebhook(ctx context.Context, id string) error {
+	return engine.post(ctx, "/webhooks/revoke", map[string]interface{}{
+		"id": json.Number(id),
+	}, nil)
+}
+
+// Queues the transaction to be sent from the backend wallet. Engine signs the transaction itself
+// and manages its nonce and gas, so only the recipient, data and value of tx are used.
+func (engine *engineClient) sendTransaction(ctx context.Context, chainId *big.Int, tx *types.Transaction) (string, error) {
+	if engine.backendWallet == "" {
+		return "", fmt.Errorf("Sending transactions through Engine requires an EngineBackendWallet in the SDK options")
+	}
+	if tx.To() == nil {
+		return "", fmt.Errorf("Engine can't queue contract deployments")
+	}
+
+	result := &engineQueueResult{}
+	if err := engine.post(ctx, fmt.Sprintf("/backend-wallet/%s/send-transaction", chainId.String()), map[string]interface{}{
+		"toAddress": tx.To().Hex(),
+		"data":      hexutil.Encode(tx.Data()),
+		"value":     tx.Value().String(),
+	}, result); err != nil {
+		return "", err
+	}
+
+	return result.QueueId, nil
+}
+
+func (engine *engineClient) getTransactionStatus(ctx context.Context, queueId string) (*engineTransactionStatus, error) {
+	result := &engineTransactionStatus{}
+	if err := engine.do(ctx, http.MethodGet, "/transaction/status/"+queueId, nil, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Polls the status of the queued transaction until Engine mines it, returning the hash of the
+// transaction that was mined, which changes when Engine resends a stuck transaction
+func (engine *engineClient) waitForMinedHash(ctx context.Context, queueId string) (common.Hash, error) {
+	for {
+		status, err := engine.getTransactionStatus(ctx, queueId)
+		if err != nil {
+			return common.Hash{}, err
+		}
+
+		switch status.Status {
+		case "mined":
+			return common.HexToHash(status.TransactionHash), nil
+		case "errored", "cancelled":
+			return common.Hash{}, fmt.Errorf("Engine transaction %s %s: %s", queueId, status.Status, status.ErrorMessage)
+		}
+
+		select {
+		case <-ctx.Done():
+			return common.Hash{}, ctx.Err()
+		case <-time.After(txWaitTimeBetweenAttempts):
+		}
+	}
+}
+
+func (engine *engineClient) post(ctx context.Context, path string, payload interface{}, out interface{}) error {
+	return engine.do(ctx, http.MethodPost, path, payload, out)
+}
+
+// Sends a request to Engine and unmarshals the "result" field of the response into out
+func (engine *engineClient) do(ctx context.Context, method string, path string, payload interface{}, out interface{}) error {
+	var reqBody io.Reader
+	if payload != nil {
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, engine.url+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+engine.accessToken)
+	if engine.backendWallet != "" {
+		req.Header.Set("x-backend-wallet-address", engine.backendWallet)
+	}
+
+	res, err := engine.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		errorResponse := &engineErrorResponse{}
+		if err := json.Unmarshal(resBody, errorResponse); err == nil && errorResponse.Error.Message != "" {
+			return fmt.Errorf("Engine request to %s failed: %s", path, errorResponse.Error.Message)
+		}
+		return fmt.Errorf("Engine request to %s failed with status code %d: %s", path, res.StatusCode, string(resBody))
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	response := struct {
+		Result json.RawMessage `json:"result"`
+	}{}
+	if err := json.Unmarshal(resBody, &response); err != nil {
+		return &unmarshalError{body: string(resBody), typeName: "engineResponse", UnderlyingError: err}
+	}
+	if err := json.Unmarshal(response.Result, out); err != nil {
+		return &unmarshalError{body: string(response.Result), typeName: fmt.Sprintf("%T", out), UnderlyingError: err}
+	}
+
+	return nil
+}
+
+// A transaction queued with thirdweb Engine, which sends it from its backend wallet and retries it
+// if it gets stuck
+type QueuedTransaction struct {
+	QueueId  string
+	engine   *engineClient
+	provider *ethclient.Client
+}
+
+// Wait for Engine to mine the queued transaction
+//
+// returns: the receipt of the mined transaction, or an error if Engine failed or cancelled it
+//
+// Example
+//
+//	receipt, err := queued.Wait(context.Background())
+func (queued *QueuedTransaction) Wait(ctx context.Context) (*types.Receipt, error) {
+	hash, err := queued.engine.waitForMinedHash(ctx, queued.QueueId)
+	if err != nil {
+		return nil, err
+	}
+
+	return queued.provider.TransactionReceipt(ctx, hash)
+}
+
+// Sends every transaction of the SDK through thirdweb Engine instead of broadcasting it. Engine
+// sends it from its backend wallet, so the signed transaction only provides the recipient, data
+// and value. Send returns once Engine has mined it, with the hash to wait on in AwaitTx.
+type engineBroadcaster struct {
+	handler *ProviderHandler
+	engine  *engineClient
+}
+
+func newEngineBroadcaster(handler *ProviderHandler, engine *engineClient) *engineBroadcaster {
+	return &engineBroadcaster{
+		handler: handler,
+		engine:  engine,
+	}
+}
+
+func (broadcaster *engineBroadcaster) sponsorsGas() {}
+
+func (broadcaster *engineBroadcaster) Send(ctx context.Context, tx *types.Transaction) (common.Hash, error) {
+	chainId, err := broadcaster.handler.GetChainID(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	queueId, err := broadcaster.engine.sendTransaction(ctx, chainId, tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return broadcaster.engine.waitForMinedHash(ctx, queueId)
+}
@@ -0,0 +1,74 @@
+package thirdweb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEngineBroadcasterWaitsForMinedHash(t *testing.T) {
+	backendWallet := "0x0000000000000000000000000000000000000b0b"
+	resentHash := common.HexToHash("0x1111")
+	minedHash := common.HexToHash("0x2222")
+
+	// Engine first reports the transaction as sent, then mined under the hash of a resent transaction
+	statusPolls := 0
+	engineServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, backendWallet, r.Header.Get("x-backend-wallet-address"))
+
+		var result interface{}
+		switch {
+		case r.URL.Path == "/backend-wallet/1337/send-transaction":
+			result = map[string]string{"queueId": "queue-1"}
+		case r.URL.Path == "/transaction/status/queue-1":
+			statusPolls += 1
+			if statusPolls == 1 {
+				result = map[string]string{"status": "sent", "transactionHash": resentHash.Hex()}
+			} else {
+				result = map[string]string{"status": "mined", "transactionHash": minedHash.Hex()}
+			}
+		default:
+			t.Fatalf("Unexpected Engine request %s", r.URL.Path)
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"result": result})
+	}))
+	defer engineServer.Close()
+
+	rpcServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Id     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "eth_chainId", req.Method)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":"0x539"}`, req.Id)))
+	}))
+	defer rpcServer.Close()
+
+	rpcClient, err := rpc.DialHTTP(rpcServer.URL)
+	assert.Nil(t, err)
+	handler, err := NewProviderHandler(ethclient.NewClient(rpcClient), "")
+	assert.Nil(t, err)
+
+	engine := newEngineClient(engineServer.URL+"/", "token", backendWallet, http.DefaultClient)
+	handler.UpdateBroadcaster(newEngineBroadcaster(handler, engine))
+
+	to := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	tx := types.NewTx(&types.LegacyTx{To: &to, Gas: 21000})
+	err = handler.getBackend().SendTransaction(context.Background(), tx)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, statusPolls)
+	assert.Equal(t, minedHash, handler.getSentTxHash(tx.Hash()))
+}
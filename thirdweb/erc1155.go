@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"math/big"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/mitchellh/mapstructure"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/thirdweb-dev/go-sdk/v2/abi"
 )
@@ -23,6 +25,8 @@ type ERC1155 struct {
 	helper    *contractHelper
 	storage   storage
 	ClaimConditions *EditionDropClaimConditions
+	ownedRequests   *singleflight.Group
+	metadata        *bind.BoundContract
 }
 
 type EditionResult struct {
@@ -30,23 +34,30 @@ type EditionResult struct {
 	err error
 }
 
-func newERC1155(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*ERC1155, error) {
-	token, err := abi.NewTokenERC1155(address, provider)
+func newERC1155(handler *ProviderHandler, address common.Address, storage storage) (*ERC1155, error) {
+	backend := handler.getBackend()
+
+	token, err := abi.NewTokenERC1155(address, backend)
 	if err != nil {
 		return nil, err
 	} 
 
-	drop, err := abi.NewDropERC1155(address, provider)
+	drop, err := abi.NewDropERC1155(address, backend)
 	if err != nil {
 		return nil, err
 	}
 	
-	helper, err := newContractHelper(address, provider, privateKey)
+	helper, err := newContractHelper(address, handler)
 	if err != nil {
 		return nil, err
 	} 
 
-	claimConditions, err := newEditionDropClaimConditions(address, provider, helper, storage)
+	claimConditions, err := newEditionDropClaimConditions(address, backend, helper, storage)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := newERC1155MetadataContract(address, backend)
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +68,8 @@ func newERC1155(provider *ethclient.Client, address common.Address, privateKey s
 		helper,
 		storage,
 		claimConditions,
+		&singleflight.Group{},
+		metadata,
 	}, nil
 	
 }
@@ -110,7 +123,7 @@ func (erc1155 *ERC1155) GetAll(ctx context.Context) ([]*EditionMetadata, error)
 		for i := 0; i < totalCount; i++ {
 			tokenIds = append(tokenIds, big.NewInt(int64(i)))
 		}
-		return fetchEditionsByTokenId(ctx, erc1155, tokenIds)
+		return fetchEditionsByTokenId(ctx, erc1155.helper.concurrency, erc1155.helper.metadataFetchTimeout, erc1155.Get, tokenIds)
 	}
 }
 
@@ -136,6 +149,9 @@ func (erc1155 *ERC1155) GetTotalCount(ctx context.Context) (int, error) {
 //
 // @extension: ERC1155Enumerable
 //
+// Concurrent calls for the same address share a single set of RPC calls, which keeps running
+// when a caller's context is cancelled so the other callers still get the result.
+//
 // address: the address of the owner of the NFTs
 //
 // returns: the metadatas and supplies of all the NFTs owned by the address
@@ -150,6 +166,35 @@ func (erc1155 *ERC1155) GetOwned(ctx context.Context, address string) ([]*Editio
 		address = erc1155.helper.GetSignerAddress().String()
 	}
 
+	result := erc1155.ownedRequests.DoChan(common.HexToAddress(address).Hex(), func() (interface{}, error) {
+		// Shared by every caller, so no single caller can cancel it, but it still gets a deadline
+		sharedCtx, cancel := context.WithTimeout(context.Background(), erc1155.helper.sharedRequestTimeout)
+		defer cancel()
+
+		return erc1155.getOwned(sharedCtx, address)
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-result:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+
+		// Each caller gets its own copy, so callers can't see each other's changes
+		shared := res.Val.([]*EditionMetadataOwner)
+		nfts := make([]*EditionMetadataOwner, len(shared))
+		for i, nft := range shared {
+			copied := *nft
+			copied.Metadata = copyNFTMetadata(nft.Metadata)
+			nfts[i] = &copied
+		}
+		return nfts, nil
+	}
+}
+
+func (erc1155 *ERC1155) getOwned(ctx context.Context, address string) ([]*EditionMetadataOwner, error) {
 	maxId, err := erc1155.token.NextTokenIdToMint(&bind.CallOpts{Context: ctx})
 	if err != nil {
 		return nil, err
@@ -168,7 +213,7 @@ func (erc1155 *ERC1155) GetOwned(ctx context.Context, address string) ([]*Editio
 	}
 
 	metadataOwners := []*EditionMetadataOwner{}
-	metadatas, err := fetchEditionsByTokenId(ctx, erc1155, ids)
+	metadatas, err := fetchEditionsByTokenId(ctx, erc1155.helper.concurrency, erc1155.helper.metadataFetchTimeout, erc1155.Get, ids)
 	if err != nil {
 		return nil, err
 	}
@@ -188,6 +233,69 @@ func (erc1155 *ERC1155) GetOwned(ctx context.Context, address string) ([]*Editio
 	return metadataOwners, nil
 }
 
+// Get the metadatas of the NFTs that match a filter.
+//
+// @extension: ERC1155
+//
+// The owner is checked on-chain with a single balanceOfBatch call before any metadata is fetched,
+// and the attribute filter is applied to the fetched metadata.
+//
+// filter: the owner, token IDs, attribute and maximum number of results to filter by
+//
+// returns: the metadata of the matching NFTs, in the order of the token IDs
+//
+// Example
+//
+//	nfts, err := contract.GetAllWithFilter(context.Background(), &thirdweb.NFTFilter{
+//		Owner:        "{{wallet_address}}",
+//		HasAttribute: &thirdweb.AttributeFilter{TraitType: "rarity", Value: "legendary"},
+//		MaxResults:   10,
+//	})
+func (erc1155 *ERC1155) GetAllWithFilter(ctx context.Context, filter *NFTFilter) ([]*NFTMetadata, error) {
+	if filter == nil {
+		filter = &NFTFilter{}
+	}
+
+	tokenIds := filter.TokenIds
+	if tokenIds == nil {
+		totalCount, err := erc1155.GetTotalCount(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < totalCount; i++ {
+			tokenIds = append(tokenIds, i)
+		}
+	}
+
+	if filter.Owner != "" && !erc1155.helper.isValidAddress(filter.Owner) {
+		return nil, ErrInvalidAddress
+	}
+
+	if filter.Owner != "" && len(tokenIds) > 0 {
+		owners := []common.Address{}
+		ids := []*big.Int{}
+		for _, tokenId := range tokenIds {
+			owners = append(owners, common.HexToAddress(filter.Owner))
+			ids = append(ids, big.NewInt(int64(tokenId)))
+		}
+
+		balances, err := erc1155.token.BalanceOfBatch(&bind.CallOpts{Context: ctx}, owners, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		ownedTokenIds := []int{}
+		for i, balance := range balances {
+			if balance.Sign() > 0 {
+				ownedTokenIds = append(ownedTokenIds, tokenIds[i])
+			}
+		}
+		tokenIds = ownedTokenIds
+	}
+
+	return fetchFilteredNFTs(ctx, erc1155.helper.concurrency, tokenIds, filter, erc1155.getTokenMetadata)
+}
+
 // Get the total supply of an NFT
 //
 // @extension: ERC1155
@@ -210,6 +318,67 @@ func (erc1155 *ERC1155) TotalSupply(ctx context.Context, tokenId int) (int, erro
 	return int(supply.Int64()), nil
 }
 
+// Get the total supply of all NFTs
+//
+// @extension: ERC1155Enumerable
+//
+// returns: the sum of the supplies of every token ID on the contract
+//
+// Example
+//
+//	totalSupply, err := contract.GetTotalCirculatingSupply(context.Background())
+func (erc1155 *ERC1155) GetTotalCirculatingSupply(ctx context.Context) (*big.Int, error) {
+	count, err := erc1155.GetTotalCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	total := big.NewInt(0)
+	for tokenId := 0; tokenId < count; tokenId++ {
+		supply, err := erc1155.token.TotalSupply(&bind.CallOpts{Context: ctx}, big.NewInt(int64(tokenId)))
+		if err != nil {
+			return nil, err
+		}
+		total.Add(total, supply)
+	}
+
+	return total, nil
+}
+
+// Get the maximum total supply of all NFTs
+//
+// @extension: ERC1155Enumerable
+//
+// This is only supported by contracts that cap the supply of each token, like the Edition Drop.
+//
+// returns: the sum of the supply caps of every token ID, or nil if any token's supply isn't capped
+//
+// Example
+//
+//	maxSupply, err := contract.GetMaxTotalSupply(context.Background())
+func (erc1155 *ERC1155) GetMaxTotalSupply(ctx context.Context) (*big.Int, error) {
+	count, err := erc1155.GetTotalCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	total := big.NewInt(0)
+	for tokenId := 0; tokenId < count; tokenId++ {
+		maxSupply, err := erc1155.drop.MaxTotalSupply(&bind.CallOpts{Context: ctx}, big.NewInt(int64(tokenId)))
+		if err != nil {
+			return nil, err
+		}
+
+		// A max supply of zero means the supply of the token isn't capped
+		if maxSupply.Sign() == 0 {
+			return nil, nil
+		}
+		total.Add(total, maxSupply)
+	}
+
+	return total, nil
+}
+
 // Get NFT balance
 //
 // @extension: ERC1155
@@ -244,6 +413,120 @@ func (erc1155 *ERC1155) BalanceOf(ctx context.Context, address string, tokenId i
 	return int(balance.Int64()), nil
 }
 
+// Verify an NFT
+//
+// @extension: ERC1155
+//
+// Checks that the NFT has been created, that the given address holds at least one of it and that
+// its metadata URI resolves to valid JSON.
+//
+// tokenId: the token ID of the NFT to verify
+//
+// ownerAddress: the address that should hold the NFT
+//
+// returns: true if every check passes, false if any check fails
+//
+// Example
+//
+//	tokenId := 0
+//	ok, err := contract.ERC1155.Verify(context.Background(), tokenId, "{{wallet_address}}")
+func (erc1155 *ERC1155) Verify(ctx context.Context, tokenId int, ownerAddress string) (bool, error) {
+	if !erc1155.helper.isValidAddress(ownerAddress) {
+		return false, ErrInvalidAddress
+	}
+
+	count, err := erc1155.token.NextTokenIdToMint(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return false, err
+	}
+	if tokenId < 0 || big.NewInt(int64(tokenId)).Cmp(count) >= 0 {
+		return false, nil
+	}
+
+	balance, err := erc1155.token.BalanceOf(&bind.CallOpts{Context: ctx}, common.HexToAddress(ownerAddress), big.NewInt(int64(tokenId)))
+	if err != nil {
+		return false, err
+	}
+	if balance.Sign() <= 0 {
+		return false, nil
+	}
+
+	uri, err := erc1155.token.Uri(&bind.CallOpts{Context: ctx}, big.NewInt(int64(tokenId)))
+	if err != nil {
+		return false, err
+	}
+
+	return hasValidMetadata(ctx, tokenId, uri, erc1155.storage)
+}
+
+// Verify NFT ownership
+//
+// @extension: ERC1155
+//
+// address: the address of the wallet to check
+//
+// tokenId: the token ID of the NFT to check
+//
+// requiredQuantity: the minimum number of the NFT the wallet needs to hold
+//
+// returns: true if the wallet holds at least the required quantity of the NFT, otherwise false
+//
+// Example
+//
+//	tokenId := 0
+//	ok, err := contract.ERC1155.VerifyOwnership(context.Background(), "{{wallet_address}}", tokenId, 1)
+func (erc1155 *ERC1155) VerifyOwnership(ctx context.Context, address string, tokenId int, requiredQuantity int) (bool, error) {
+	return erc1155.VerifyOwnershipBatch(ctx, address, []int{tokenId}, []int{requiredQuantity})
+}
+
+// Verify ownership of several NFTs
+//
+// @extension: ERC1155
+//
+// The balances are fetched with a single call.
+//
+// address: the address of the wallet to check
+//
+// tokenIds: the token IDs of the NFTs to check
+//
+// quantities: the minimum number of each NFT the wallet needs to hold, in the same order as the token IDs
+//
+// returns: true if the wallet holds at least the required quantity of every NFT, otherwise false
+//
+// Example
+//
+//	tokenIds := []int{0, 1}
+//	quantities := []int{1, 5}
+//	ok, err := contract.ERC1155.VerifyOwnershipBatch(context.Background(), "{{wallet_address}}", tokenIds, quantities)
+func (erc1155 *ERC1155) VerifyOwnershipBatch(ctx context.Context, address string, tokenIds []int, quantities []int) (bool, error) {
+	if len(tokenIds) != len(quantities) {
+		return false, fmt.Errorf("%d token IDs were provided, but %d quantities", len(tokenIds), len(quantities))
+	}
+	if !erc1155.helper.isValidAddress(address) {
+		return false, ErrInvalidAddress
+	}
+
+	owners := []common.Address{}
+	ids := []*big.Int{}
+	for _, tokenId := range tokenIds {
+		owners = append(owners, common.HexToAddress(address))
+		ids = append(ids, big.NewInt(int64(tokenId)))
+	}
+
+	balances, err := erc1155.token.BalanceOfBatch(&bind.CallOpts{Context: ctx}, owners, ids)
+	if err != nil {
+		return false, err
+	}
+
+	for i, balance := range balances {
+		if balance.Cmp(big.NewInt(int64(quantities[i]))) < 0 {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
 // Check NFT approval
 //
 // @extension: ERC1155
@@ -264,6 +547,42 @@ func (erc1155 *ERC1155) IsApproved(ctx context.Context, owner string, operator s
 	return erc1155.token.IsApprovedForAll(&bind.CallOpts{Context: ctx}, common.HexToAddress(owner), common.HexToAddress(operator))
 }
 
+// Get the known operators approved for all NFTs of a wallet
+//
+// @extension: ERC1155
+//
+// Only the operators of the common NFT marketplaces are checked, since approvals can't be listed
+// on-chain. The list can be changed with the KnownOperators SDK option.
+//
+// address: the address of the wallet, defaults to the connected wallet if empty
+//
+// returns: the known operators that are approved for all NFTs of the wallet
+//
+// Example
+//
+//	operators, err := contract.GetApprovedOperators(context.Background(), "{{wallet_address}}")
+func (erc1155 *ERC1155) GetApprovedOperators(ctx context.Context, address string) ([]string, error) {
+	if address == "" {
+		address = erc1155.helper.GetSignerAddress().String()
+	}
+	if !erc1155.helper.isValidAddress(address) {
+		return nil, ErrInvalidAddress
+	}
+
+	approved := []string{}
+	for _, operator := range erc1155.helper.knownOperators {
+		isApproved, err := erc1155.IsApproved(ctx, address, operator)
+		if err != nil {
+			return nil, err
+		}
+		if isApproved {
+			approved = append(approved, common.HexToAddress(operator).Hex())
+		}
+	}
+
+	return approved, nil
+}
+
 // Transfer NFTs
 //
 // @extension: ERC1155
@@ -283,8 +602,8 @@ func (erc1155 *ERC1155) IsApproved(ctx context.Context, owner string, operator s
 //	amount := 1
 //
 //	tx, err := contract.Transfer(context.Background(), to, tokenId, amount)
-func (erc1155 *ERC1155) Transfer(ctx context.Context, to string, tokenId int, amount int) (*types.Transaction, error) {
-	txOpts, err := erc1155.helper.GetTxOptions(ctx)
+func (erc1155 *ERC1155) Transfer(ctx context.Context, to string, tokenId int, amount int, options ...*TransactionOptions) (*types.Transaction, error) {
+	txOpts, err := erc1155.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -317,9 +636,9 @@ func (erc1155 *ERC1155) Transfer(ctx context.Context, to string, tokenId int, am
 //	tokenId := 0
 //	amount := 1
 //	tx, err := contract.Burn(context.Background(), tokenId, amount)
-func (erc1155 *ERC1155) Burn(ctx context.Context, tokenId int, amount int) (*types.Transaction, error) {
+func (erc1155 *ERC1155) Burn(ctx context.Context, tokenId int, amount int, options ...*TransactionOptions) (*types.Transaction, error) {
 	address := erc1155.helper.GetSignerAddress()
-	txOpts, err := erc1155.helper.GetTxOptions(ctx)
+	txOpts, err := erc1155.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -353,8 +672,8 @@ func (erc1155 *ERC1155) Burn(ctx context.Context, tokenId int, amount int) (*typ
 // 	approved := true
 //
 // 	tx, err := contract.SetApprovalForAll(context.Background(), operator, approved)
-func (erc1155 *ERC1155) SetApprovalForAll(ctx context.Context, operator string, approved bool) (*types.Transaction, error) {
-	txOpts, err := erc1155.helper.GetTxOptions(ctx)
+func (erc1155 *ERC1155) SetApprovalForAll(ctx context.Context, operator string, approved bool, options ...*TransactionOptions) (*types.Transaction, error) {
+	txOpts, err := erc1155.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -369,6 +688,36 @@ func (erc1155 *ERC1155) SetApprovalForAll(ctx context.Context, operator string,
 	}
 }
 
+// Set approval for all NFTs for several operators
+//
+// @extension: ERC1155
+//
+// Each operator needs its own transaction. The transactions are sent concurrently and all of them
+// are awaited before returning.
+//
+// operators: the addresses of the operators to set the approval for
+//
+// approved: true to approve all NFTs, false to remove the approvals
+//
+// returns: the transaction receipts of the approvals, in the same order as the operators. If some
+// approvals fail, the others are still returned, with nil for the failed ones, along with the error.
+//
+// Example
+//
+//	operators := []string{"{{wallet_address}}", "{{wallet_address}}"}
+//	txs, err := contract.ERC1155.SetApprovalForAllBatch(context.Background(), operators, true)
+func (erc1155 *ERC1155) SetApprovalForAllBatch(ctx context.Context, operators []string, approved bool) ([]*types.Transaction, error) {
+	for _, operator := range operators {
+		if !erc1155.helper.isValidAddress(operator) {
+			return nil, ErrInvalidAddress
+		}
+	}
+
+	return erc1155.helper.sendParallel(ctx, len(operators), func(ctx context.Context, index int, options *TransactionOptions) (*types.Transaction, error) {
+		return erc1155.SetApprovalForAll(ctx, operators[index], approved, options)
+	})
+}
+
 // Mint an NFT
 //
 // @extension: ERC1155Mintable
@@ -392,9 +741,9 @@ func (erc1155 *ERC1155) SetApprovalForAll(ctx context.Context, operator string,
 // 	}
 //
 // 	tx, err := contract.Mint(context.Background(), metadataWithSupply)
-func (erc1155 *ERC1155) Mint(ctx context.Context, metadataWithSupply *EditionMetadataInput) (*types.Transaction, error) {
+func (erc1155 *ERC1155) Mint(ctx context.Context, metadataWithSupply *EditionMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
 	address := erc1155.helper.GetSignerAddress().String()
-	return erc1155.MintTo(ctx, address, metadataWithSupply)
+	return erc1155.MintTo(ctx, address, metadataWithSupply, options...)
 }
 
 // Mint an NFT to a specific wallet
@@ -422,14 +771,14 @@ func (erc1155 *ERC1155) Mint(ctx context.Context, metadataWithSupply *EditionMet
 // 	}
 //
 // 	tx, err := contract.MintTo(context.Background(), "{{wallet_address}}", metadataWithSupply)
-func (erc1155 *ERC1155) MintTo(ctx context.Context, address string, metadataWithSupply *EditionMetadataInput) (*types.Transaction, error) {
+func (erc1155 *ERC1155) MintTo(ctx context.Context, address string, metadataWithSupply *EditionMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
 	uri, err := uploadOrExtractUri(ctx, metadataWithSupply.Metadata, erc1155.storage)
 	if err != nil {
 		return nil, err
 	}
 
 	MaxUint256 := new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 256), common.Big1)
-	txOpts, err := erc1155.helper.GetTxOptions(ctx)
+	txOpts, err := erc1155.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -463,9 +812,9 @@ func (erc1155 *ERC1155) MintTo(ctx context.Context, address string, metadataWith
 // 	additionalSupply := 100
 //
 // 	tx, err := contract.MintAdditionalSupply(context.Background(), tokenId, additionalSupply)
-func (erc1155 *ERC1155) MintAdditionalSupply(ctx context.Context, tokenId int, additionalSupply int) (*types.Transaction, error) {
+func (erc1155 *ERC1155) MintAdditionalSupply(ctx context.Context, tokenId int, additionalSupply int, options ...*TransactionOptions) (*types.Transaction, error) {
 	address := erc1155.helper.GetSignerAddress().String()
-	return erc1155.MintAdditionalSupplyTo(ctx, address, tokenId, additionalSupply)
+	return erc1155.MintAdditionalSupplyTo(ctx, address, tokenId, additionalSupply, options...)
 }
 
 // Mint additional supply of an NFT to a specific wallet
@@ -474,7 +823,7 @@ func (erc1155 *ERC1155) MintAdditionalSupply(ctx context.Context, tokenId int, a
 //
 // to: address of the wallet to mint NFTs to
 //
-// tokenId: token Id to mint additional supply of
+// tokenId: token Id to mint additional supply of, which must already have been minted
 //
 // additionalySupply: additional supply to mint
 //
@@ -487,13 +836,22 @@ func (erc1155 *ERC1155) MintAdditionalSupply(ctx context.Context, tokenId int, a
 // 	additionalSupply := 100
 //
 // 	tx, err := contract.MintAdditionalSupplyTo(context.Background(), to, tokenId, additionalSupply)
-func (erc1155 *ERC1155) MintAdditionalSupplyTo(ctx context.Context, to string, tokenId int, additionalSupply int) (*types.Transaction, error) {
+func (erc1155 *ERC1155) MintAdditionalSupplyTo(ctx context.Context, to string, tokenId int, additionalSupply int, options ...*TransactionOptions) (*types.Transaction, error) {
+	// Minting to an unused token ID would create a new token without metadata
+	totalCount, err := erc1155.GetTotalCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if tokenId < 0 || tokenId >= totalCount {
+		return nil, fmt.Errorf("Token %d does not exist, only %d tokens have been minted", tokenId, totalCount)
+	}
+
 	metadata, err := erc1155.getTokenMetadata(ctx, tokenId)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := erc1155.helper.GetTxOptions(ctx)
+	txOpts, err := erc1155.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -539,8 +897,8 @@ func (erc1155 *ERC1155) MintAdditionalSupplyTo(ctx context.Context, to string, t
 //	}
 //
 //	tx, err := contract.MintBatch(context.Background(), metadatasWithSupply)
-func (erc1155 *ERC1155) MintBatch(ctx context.Context, metadatasWithSupply []*EditionMetadataInput) (*types.Transaction, error) {
-	return erc1155.MintBatchTo(ctx, erc1155.helper.GetSignerAddress().String(), metadatasWithSupply)
+func (erc1155 *ERC1155) MintBatch(ctx context.Context, metadatasWithSupply []*EditionMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
+	return erc1155.MintBatchTo(ctx, erc1155.helper.GetSignerAddress().String(), metadatasWithSupply, options...)
 }
 
 // Mint many NFTs to a specific wallet
@@ -573,7 +931,7 @@ func (erc1155 *ERC1155) MintBatch(ctx context.Context, metadatasWithSupply []*Ed
 //	}
 //
 //	tx, err := contract.MintBatchTo(context.Background(), "{{wallet_address}}", metadatasWithSupply)
-func (erc1155 *ERC1155) MintBatchTo(ctx context.Context, to string, metadatasWithSupply []*EditionMetadataInput) (*types.Transaction, error) {
+func (erc1155 *ERC1155) MintBatchTo(ctx context.Context, to string, metadatasWithSupply []*EditionMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
 	metadatas := []*NFTMetadataInput{}
 	for _, metadataWithSupply := range metadatasWithSupply {
 		metadatas = append(metadatas, metadataWithSupply.Metadata)
@@ -610,7 +968,7 @@ func (erc1155 *ERC1155) MintBatchTo(ctx context.Context, to string, metadatasWit
 		encoded = append(encoded, tx.Data())
 	}
 
-	txOpts, err := erc1155.helper.GetTxOptions(ctx)
+	txOpts, err := erc1155.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -658,7 +1016,7 @@ func (erc1155 *ERC1155) MintBatchTo(ctx context.Context, to string, metadatasWit
 //	}
 //
 //	tx, err := contract.CreateBatch(context.Background(), metadatasWithSupply)
-func (erc1155 *ERC1155) CreateBatch(ctx context.Context, metadatas []*NFTMetadataInput) (*types.Transaction, error) {
+func (erc1155 *ERC1155) CreateBatch(ctx context.Context, metadatas []*NFTMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
 	startNumber, err := erc1155.drop.NextTokenIdToMint(&bind.CallOpts{Context: ctx})
 	if err != nil {
 		return nil, err
@@ -688,7 +1046,7 @@ func (erc1155 *ERC1155) CreateBatch(ctx context.Context, metadatas []*NFTMetadat
 		return nil, err
 	}
 
-	txOpts, err := erc1155.helper.GetTxOptions(ctx)
+	txOpts, err := erc1155.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -721,9 +1079,9 @@ func (erc1155 *ERC1155) CreateBatch(ctx context.Context, metadatas []*NFTMetadat
 //	quantity = 1
 //
 //	tx, err := contract.ClaimTo(context.Background(), tokenId, quantity)
-func (erc1155 *ERC1155) Claim(ctx context.Context, tokenId int, quantity int) (*types.Transaction, error) {
+func (erc1155 *ERC1155) Claim(ctx context.Context, tokenId int, quantity int, options ...*TransactionOptions) (*types.Transaction, error) {
 	address := erc1155.helper.GetSignerAddress().String()
-	return erc1155.ClaimTo(ctx, address, tokenId, quantity)
+	return erc1155.ClaimTo(ctx, address, tokenId, quantity, options...)
 }
 
 // Claim an NFT to a specific wallet
@@ -745,7 +1103,7 @@ func (erc1155 *ERC1155) Claim(ctx context.Context, tokenId int, quantity int) (*
 //	quantity = 1
 //
 //	tx, err := contract.ClaimTo(context.Background(), address, tokenId, quantity)
-func (erc1155 *ERC1155) ClaimTo(ctx context.Context, destinationAddress string, tokenId int, quantity int) (*types.Transaction, error) {
+func (erc1155 *ERC1155) ClaimTo(ctx context.Context, destinationAddress string, tokenId int, quantity int, options ...*TransactionOptions) (*types.Transaction, error) {
 	claimVerification, err := erc1155.prepareClaim(ctx, tokenId, quantity)
 	if err != nil {
 		return nil, err
@@ -756,7 +1114,7 @@ func (erc1155 *ERC1155) ClaimTo(ctx context.Context, destinationAddress string,
 		return nil, err
 	}
 
-	txOpts, err := erc1155.helper.GetTxOptions(ctx)
+	txOpts, err := erc1155.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -816,6 +1174,118 @@ func (erc1155 *ERC1155) prepareClaim(ctx context.Context, tokenId int, quantity
 }
 
 
+// Check if a wallet can claim NFTs
+//
+// @extension: ERC1155ClaimCustom | ERC1155ClaimPhasesV2 | ERC1155ClaimConditionsV2
+//
+// Checks the active claim condition of the token on the client side, so claims that would revert
+// can be caught before any gas is spent.
+//
+// tokenId: the token ID of the NFT to claim
+//
+// quantity: the number of NFTs to claim
+//
+// address: the address of the wallet that would claim the NFTs
+//
+// returns: true if the wallet can claim, otherwise false and an error explaining why it can't
+//
+// Example
+//
+//	address = "{{wallet_address}}"
+//	tokenId = 0
+//	quantity = 1
+//
+//	canClaim, err := contract.CanClaim(context.Background(), tokenId, quantity, address)
+//	if !canClaim {
+//		fmt.Println("Can't claim:", err)
+//	}
+func (erc1155 *ERC1155) CanClaim(ctx context.Context, tokenId int, quantity int, address string) (bool, error) {
+	active, err := erc1155.ClaimConditions.GetActive(ctx, tokenId)
+	if err != nil {
+		if strings.Contains(err.Error(), "!CONDITION") || strings.Contains(err.Error(), "no active mint condition") {
+			return false, &claimIneligibleError{reason: NoClaimConditionSet}
+		}
+
+		return false, err
+	}
+
+	if active.StartTime.After(time.Now()) {
+		return false, &claimIneligibleError{reason: NoActiveClaimPhase}
+	}
+
+	if active.AvailableSupply.Cmp(big.NewInt(int64(quantity))) < 0 {
+		return false, &claimIneligibleError{reason: NotEnoughSupply}
+	}
+
+	merkleMetadata, err := erc1155.ClaimConditions.GetMerkleMetadata(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	claimVerification, err := prepareClaim(
+		ctx,
+		address,
+		quantity,
+		active,
+		merkleMetadata,
+		erc1155.helper,
+		erc1155.storage,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	// An allowlist without an entry for this address falls back to the per wallet limit of the
+	// condition, and a limit of zero means only allowlisted wallets can claim
+	if claimVerification.MaxClaimable.Cmp(big.NewInt(0)) == 0 {
+		return false, &claimIneligibleError{reason: AddressNotAllowed}
+	}
+
+	conditionId, err := erc1155.drop.GetActiveClaimConditionId(&bind.CallOpts{Context: ctx}, big.NewInt(int64(tokenId)))
+	if err != nil {
+		return false, err
+	}
+
+	claimed, err := erc1155.drop.GetSupplyClaimedByWallet(
+		&bind.CallOpts{Context: ctx},
+		big.NewInt(int64(tokenId)),
+		conditionId,
+		common.HexToAddress(address),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	if big.NewInt(0).Add(claimed, big.NewInt(int64(quantity))).Cmp(claimVerification.MaxClaimable) > 0 {
+		return false, &claimIneligibleError{reason: ExceedsMaxClaimable}
+	}
+
+	totalPrice := big.NewInt(0).Mul(claimVerification.Price, big.NewInt(int64(quantity)))
+	if totalPrice.Cmp(big.NewInt(0)) > 0 {
+		var balance *big.Int
+		if isNativeToken(claimVerification.CurrencyAddress) {
+			balance, err = erc1155.helper.GetProvider().BalanceAt(ctx, common.HexToAddress(address), nil)
+		} else {
+			var currency *abi.IERC20
+			currency, err = abi.NewIERC20(common.HexToAddress(claimVerification.CurrencyAddress), erc1155.helper.GetProvider())
+			if err != nil {
+				return false, err
+			}
+			balance, err = currency.BalanceOf(&bind.CallOpts{Context: ctx}, common.HexToAddress(address))
+		}
+		if err != nil {
+			return false, err
+		}
+
+		if balance.Cmp(totalPrice) < 0 {
+			return false, &claimIneligibleError{reason: InsufficientBalance}
+		}
+	}
+
+	return true, nil
+}
+
+
 func (erc1155 *ERC1155) getTokenMetadata(ctx context.Context, tokenId int) (*NFTMetadata, error) {
 	if uri, err := erc1155.token.Uri(
 		&bind.CallOpts{Context: ctx},
@@ -833,32 +1303,49 @@ func (erc1155 *ERC1155) getTokenMetadata(ctx context.Context, tokenId int) (*NFT
 	}
 }
 
-func fetchEditionsByTokenId(ctx context.Context, erc1155 *ERC1155, tokenIds []*big.Int) ([]*EditionMetadata, error) {
+// The fetch function is passed in rather than the module so the concurrency can be tested on its own
+func fetchEditionsByTokenId(
+	ctx context.Context,
+	limiter *concurrencyLimiter,
+	timeout time.Duration,
+	fetch func(ctx context.Context, tokenId int) (*EditionMetadata, error),
+	tokenIds []*big.Int,
+) ([]*EditionMetadata, error) {
 	total := len(tokenIds)
 
-	ch := make(chan *EditionResult)
+	// Buffered so the goroutines can finish while later ones are still waiting for a slot
+	ch := make(chan *EditionResult, total)
 	// fetch all nfts in parallel
 	for i := 0; i < total; i++ {
-		go func(id int) {
-			if nft, err := erc1155.Get(ctx, id); err == nil {
+		id := int(tokenIds[i].Int64())
+		limiter.Go(func() {
+			// A hanging gateway times out instead of blocking the results
+			fetchCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			if nft, err := fetch(fetchCtx, id); err == nil {
 				ch <- &EditionResult{nft, nil}
 			} else {
-				fmt.Println(err)
 				ch <- &EditionResult{nil, err}
 			}
-		}(i)
+		})
 	}
-	// wait for all goroutines to emit
-	results := make([]*EditionResult, total)
-	for i := range results {
-		results[i] = <-ch
-	}
-	// filter out errors
+	// wait for all goroutines to emit, keeping the first error (an ErrGatewayTimeout if the
+	// metadata fetch timed out)
 	nfts := []*EditionMetadata{}
-	for _, res := range results {
-		if res.nft != nil {
-			nfts = append(nfts, res.nft)
+	var firstErr error
+	for i := 0; i < total; i++ {
+		res := <-ch
+		if res.err != nil {
+			if firstErr == nil {
+				firstErr = res.err
+			}
+			continue
 		}
+		nfts = append(nfts, res.nft)
+	}
+	if firstErr != nil {
+		return nil, firstErr
 	}
 	// Sort by ID
 	sort.SliceStable(nfts, func(i, j int) bool {
@@ -0,0 +1,262 @@
+package thirdweb
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"strings"
+
+	ethAbi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// The generated TokenERC1155 bindings predate per-token metadata updates, so these functions are
+// called through their ABI. Contracts deployed without them revert on every call, which is reported
+// as an unsupportedFunctionError.
+const erc1155MetadataAbi = `[{
+	"type": "function",
+	"name": "setTokenURI",
+	"stateMutability": "nonpayable",
+	"inputs": [{"name": "tokenId", "type": "uint256"}, {"name": "uri", "type": "string"}],
+	"outputs": []
+}, {
+	"type": "function",
+	"name": "setTokenURIBatch",
+	"stateMutability": "nonpayable",
+	"inputs": [{"name": "tokenIds", "type": "uint256[]"}, {"name": "uris", "type": "string[]"}],
+	"outputs": []
+}, {
+	"type": "function",
+	"name": "freezeMetadata",
+	"stateMutability": "nonpayable",
+	"inputs": [{"name": "tokenId", "type": "uint256"}],
+	"outputs": []
+}, {
+	"type": "function",
+	"name": "isMetadataFrozen",
+	"stateMutability": "view",
+	"inputs": [{"name": "tokenId", "type": "uint256"}],
+	"outputs": [{"name": "", "type": "bool"}]
+}]`
+
+// Update the metadata of an NFT
+//
+// @extension: ERC1155
+//
+// tokenId: the token ID of the NFT to update
+//
+// metadata: the new metadata of the NFT
+//
+// returns: the transaction receipt of the update, ErrMetadataFrozen if the metadata is frozen, or
+// ErrFunctionNotSupported if the contract can't update the metadata of its NFTs
+//
+// Example
+//
+//	tx, err := contract.UpdateMetadata(context.Background(), 0, &thirdweb.NFTMetadataInput{
+//		Name: "Updated NFT",
+//	})
+func (erc1155 *ERC1155) UpdateMetadata(ctx context.Context, tokenId int, metadata *NFTMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
+	frozen, err := erc1155.IsMetadataFrozen(ctx, tokenId)
+	if err != nil {
+		return nil, err
+	}
+	if frozen {
+		return nil, &ErrMetadataFrozen{TokenId: tokenId}
+	}
+
+	uri, err := uploadOrExtractUri(ctx, metadata, erc1155.storage)
+	if err != nil {
+		return nil, err
+	}
+
+	return erc1155.transactMetadata(ctx, "setTokenURI", options, big.NewInt(int64(tokenId)), uri)
+}
+
+// Update the metadata of many NFTs
+//
+// @extension: ERC1155
+//
+// The new metadatas are uploaded in parallel, then all the token URIs are set in a single
+// setTokenURIBatch transaction, which needs a contract that supports batch URI updates. The
+// transaction reverts if the metadata of any of the NFTs is frozen.
+//
+// updates: the new metadata of each NFT, by token ID
+//
+// returns: the transaction receipt of the update, or ErrFunctionNotSupported if the contract can't
+// update the metadata of its NFTs in batches
+//
+// Example
+//
+//	tx, err := contract.UpdateMetadataBatch(context.Background(), map[int]*thirdweb.NFTMetadataInput{
+//		0: {Name: "Updated NFT 0"},
+//		1: {Name: "Updated NFT 1"},
+//	})
+func (erc1155 *ERC1155) UpdateMetadataBatch(ctx context.Context, updates map[int]*NFTMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
+	// An empty batch changes nothing, so calling it tells us if the contract has the function
+	// before anything gets uploaded
+	var out []interface{}
+	opts := &bind.CallOpts{Context: ctx, From: erc1155.helper.GetSignerAddress()}
+	if err := erc1155.metadata.Call(opts, &out, "setTokenURIBatch", []*big.Int{}, []string{}); err != nil {
+		if isMissingFunctionError(err) {
+			return nil, &unsupportedFunctionError{
+				typeName: "ERC1155",
+				body:     "The contract doesn't implement batch metadata updates.",
+			}
+		}
+		return nil, err
+	}
+
+	// Sorted so the same updates always send the same transaction
+	tokenIds := []int{}
+	for tokenId := range updates {
+		tokenIds = append(tokenIds, tokenId)
+	}
+	sort.Ints(tokenIds)
+
+	metadatas := []*NFTMetadataInput{}
+	ids := []*big.Int{}
+	for _, tokenId := range tokenIds {
+		metadatas = append(metadatas, updates[tokenId])
+		ids = append(ids, big.NewInt(int64(tokenId)))
+	}
+
+	uris, err := uploadMetadatas(ctx, erc1155.helper.concurrency, metadatas, erc1155.storage)
+	if err != nil {
+		return nil, err
+	}
+
+	return erc1155.transactMetadata(ctx, "setTokenURIBatch", options, ids, uris)
+}
+
+// Freeze the metadata of an NFT
+//
+// @extension: ERC1155
+//
+// Once frozen, the metadata of the NFT can't be updated anymore.
+//
+// tokenId: the token ID of the NFT to freeze the metadata of
+//
+// returns: the transaction receipt of the freeze, or ErrFunctionNotSupported if the contract can't
+// freeze the metadata of its NFTs
+//
+// Example
+//
+//	tx, err := contract.FreezeMetadata(context.Background(), 0)
+func (erc1155 *ERC1155) FreezeMetadata(ctx context.Context, tokenId int, options ...*TransactionOptions) (*types.Transaction, error) {
+	if _, err := erc1155.IsMetadataFrozen(ctx, tokenId); err != nil {
+		return nil, err
+	}
+
+	return erc1155.transactMetadata(ctx, "freezeMetadata", options, big.NewInt(int64(tokenId)))
+}
+
+// Check if the metadata of an NFT is frozen
+//
+// @extension: ERC1155
+//
+// tokenId: the token ID of the NFT to check
+//
+// returns: true if the metadata of the NFT can't be updated anymore, or ErrFunctionNotSupported if
+// the contract can't freeze the metadata of its NFTs
+//
+// Example
+//
+//	frozen, err := contract.IsMetadataFrozen(context.Background(), 0)
+func (erc1155 *ERC1155) IsMetadataFrozen(ctx context.Context, tokenId int) (bool, error) {
+	var out []interface{}
+	if err := erc1155.metadata.Call(&bind.CallOpts{Context: ctx}, &out, "isMetadataFrozen", big.NewInt(int64(tokenId))); err != nil {
+		// isMetadataFrozen is a plain view, so a revert means the contract doesn't have it
+		if isRevertError(err) {
+			return false, &unsupportedFunctionError{
+				typeName: "ERC1155",
+				body:     "The contract doesn't implement per token metadata updates.",
+			}
+		}
+		return false, err
+	}
+
+	return out[0].(bool), nil
+}
+
+func (erc1155 *ERC1155) transactMetadata(ctx context.Context, method string, options []*TransactionOptions, args ...interface{}) (*types.Transaction, error) {
+	txOpts, err := erc1155.helper.GetTxOptions(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := erc1155.metadata.Transact(txOpts, method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return erc1155.helper.AwaitTx(ctx, tx.Hash())
+}
+
+// Calls to a function the contract doesn't have revert without any revert data, while the checks
+// inside a function that exists (like its permissions) revert with a reason
+func isMissingFunctionError(err error) bool {
+	if !isRevertError(err) {
+		return false
+	}
+
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		data, _ := dataErr.ErrorData().(string)
+		if data != "" && data != "0x" {
+			return false
+		}
+	}
+
+	// Hardhat names the missing function instead of returning revert data
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "function selector was not recognized") || !strings.Contains(message, "reverted:")
+}
+
+func newERC1155MetadataContract(address common.Address, backend bind.ContractBackend) (*bind.BoundContract, error) {
+	parsedAbi, err := ethAbi.JSON(strings.NewReader(erc1155MetadataAbi))
+	if err != nil {
+		return nil, err
+	}
+
+	return bind.NewBoundContract(address, parsedAbi, backend, backend, backend), nil
+}
+
+// Uploads each metadata separately and in parallel, unlike uploadOrExtractUris which uploads them
+// together under a single base URI. Returns the URIs in the order of the metadatas, or the first
+// upload error.
+func uploadMetadatas(ctx context.Context, limiter *concurrencyLimiter, metadatas []*NFTMetadataInput, storage storage) ([]string, error) {
+	type uploadResult struct {
+		index int
+		uri   string
+		err   error
+	}
+
+	// Buffered so the goroutines can finish while later ones are still waiting for a slot
+	ch := make(chan *uploadResult, len(metadatas))
+	for i, metadata := range metadatas {
+		index, metadata := i, metadata
+		limiter.Go(func() {
+			uri, err := uploadOrExtractUri(ctx, metadata, storage)
+			ch <- &uploadResult{index, uri, err}
+		})
+	}
+
+	uris := make([]string, len(metadatas))
+	var firstErr error
+	for range metadatas {
+		result := <-ch
+		if result.err != nil && firstErr == nil {
+			firstErr = result.err
+		}
+		uris[result.index] = result.uri
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return uris, nil
+}
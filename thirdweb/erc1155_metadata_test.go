@@ -0,0 +1,63 @@
+package thirdweb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadMetadatas(t *testing.T) {
+	storage := &mockStorage{}
+	metadatas := []*NFTMetadataInput{
+		{Name: "NFT 0"},
+		{Name: "NFT 1"},
+		{Name: "NFT 2"},
+	}
+
+	// A single slot runs the uploads one at a time, in order
+	uris, err := uploadMetadatas(context.Background(), newConcurrencyLimiter(1), metadatas, storage)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ipfs://upload1/0", "ipfs://upload2/0", "ipfs://upload3/0"}, uris)
+	assert.Equal(t, 3, storage.uploads)
+
+	uris, err = uploadMetadatas(context.Background(), nil, []*NFTMetadataInput{}, storage)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(uris))
+}
+
+func TestUpdateMetadataWithoutMetadataFunctions(t *testing.T) {
+	// Editions deployed without per token metadata updates revert on isMetadataFrozen
+	edition, closeServer := getEditionWithFailingCalls(t, "execution reverted", 0)
+	defer closeServer()
+
+	_, err := edition.IsMetadataFrozen(context.Background(), 0)
+	assert.ErrorIs(t, err, ErrFunctionNotSupported)
+
+	_, err = edition.UpdateMetadata(context.Background(), 0, &NFTMetadataInput{Name: "Updated NFT"})
+	assert.ErrorIs(t, err, ErrFunctionNotSupported)
+
+	_, err = edition.FreezeMetadata(context.Background(), 0)
+	assert.ErrorIs(t, err, ErrFunctionNotSupported)
+}
+
+func TestUpdateMetadataBatchWithoutBatchFunction(t *testing.T) {
+	// Editions without setTokenURIBatch revert with no reason on the empty batch
+	edition, closeServer := getEditionWithFailingCalls(t, "execution reverted", 0)
+	defer closeServer()
+
+	_, err := edition.UpdateMetadataBatch(context.Background(), map[int]*NFTMetadataInput{
+		0: {Name: "Updated NFT 0"},
+	})
+	assert.ErrorIs(t, err, ErrFunctionNotSupported)
+
+	// Reverts with a reason come from inside the function, so they aren't reported as unsupported
+	edition, closeServer = getEditionWithFailingCalls(t, "execution reverted: Permissions: account is missing role", 0)
+	defer closeServer()
+
+	_, err = edition.UpdateMetadataBatch(context.Background(), map[int]*NFTMetadataInput{
+		0: {Name: "Updated NFT 0"},
+	})
+	assert.NotNil(t, err)
+	assert.NotErrorIs(t, err, ErrFunctionNotSupported)
+}
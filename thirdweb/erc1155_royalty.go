@@ -0,0 +1,94 @@
+package thirdweb
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Get the default royalty info
+//
+// @extension: Royalty
+//
+// returns: the recipient and basis points of the royalties applied to every NFT without an override
+//
+// Example
+//
+//	royaltyInfo, err := contract.ERC1155.GetDefaultRoyaltyInfo(context.Background())
+//	fmt.Println(royaltyInfo.Seller, royaltyInfo.SellerFeeBasisPoints)
+func (erc1155 *ERC1155) GetDefaultRoyaltyInfo(ctx context.Context) (*RoyaltyInfo, error) {
+	recipient, bps, err := erc1155.token.GetDefaultRoyaltyInfo(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RoyaltyInfo{
+		Seller:               recipient.Hex(),
+		SellerFeeBasisPoints: int(bps),
+	}, nil
+}
+
+// Get the royalty info of an NFT
+//
+// @extension: Royalty
+//
+// tokenId: the token ID of the NFT to get the royalty info of
+//
+// returns: the recipient and basis points of the royalties of the NFT, which are the default
+// royalty info unless the NFT has an override
+//
+// Example
+//
+//	tokenId := 0
+//	royaltyInfo, err := contract.ERC1155.GetRoyaltyInfoForToken(context.Background(), tokenId)
+func (erc1155 *ERC1155) GetRoyaltyInfoForToken(ctx context.Context, tokenId int) (*RoyaltyInfo, error) {
+	recipient, bps, err := erc1155.token.GetRoyaltyInfoForToken(&bind.CallOpts{Context: ctx}, big.NewInt(int64(tokenId)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &RoyaltyInfo{
+		Seller:               recipient.Hex(),
+		SellerFeeBasisPoints: int(bps),
+	}, nil
+}
+
+// Set the royalty info of an NFT
+//
+// @extension: Royalty
+//
+// tokenId: the token ID of the NFT to override the royalty info of
+//
+// recipient: the address to receive the royalties of the NFT
+//
+// bps: the cut of secondary sales paid to the recipient, in basis points
+//
+// returns: the transaction receipt of the update
+//
+// Example
+//
+//	tokenId := 0
+//	tx, err := contract.ERC1155.SetTokenRoyaltyInfo(context.Background(), tokenId, "{{wallet_address}}", 500)
+func (erc1155 *ERC1155) SetTokenRoyaltyInfo(ctx context.Context, tokenId int, recipient string, bps int, options ...*TransactionOptions) (*types.Transaction, error) {
+	if !erc1155.helper.isValidAddress(recipient) {
+		return nil, ErrInvalidAddress
+	}
+
+	txOpts, err := erc1155.helper.GetTxOptions(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	if tx, err := erc1155.token.SetRoyaltyInfoForToken(
+		txOpts,
+		big.NewInt(int64(tokenId)),
+		common.HexToAddress(recipient),
+		big.NewInt(int64(bps)),
+	); err != nil {
+		return nil, err
+	} else {
+		return erc1155.helper.AwaitTx(ctx, tx.Hash())
+	}
+}
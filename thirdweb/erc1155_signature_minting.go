@@ -9,10 +9,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
 	signerTypes "github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/google/uuid"
 
@@ -21,16 +19,22 @@ import (
 
 // You can access this interface from the edition contract under the
 // signature interface
+//
+// Signed payloads work as mint vouchers: a wallet with the minter role signs a payload with
+// Generate, or GenerateFromTokenId to mint more of an existing token, and the recipient passes it
+// to Mint from an SDK connected to their own wallet.
 type ERC1155SignatureMinting struct {
 	abi     *abi.TokenERC1155
 	Helper  *contractHelper
 	storage storage
 }
 
-func newERC1155SignatureMinting(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*ERC1155SignatureMinting, error) {
-	if contractAbi, err := abi.NewTokenERC1155(address, provider); err != nil {
+func newERC1155SignatureMinting(handler *ProviderHandler, address common.Address, storage storage) (*ERC1155SignatureMinting, error) {
+	backend := handler.getBackend()
+
+	if contractAbi, err := abi.NewTokenERC1155(address, backend); err != nil {
 		return nil, err
-	} else if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+	} else if helper, err := newContractHelper(address, handler); err != nil {
 		return nil, err
 	} else {
 		return &ERC1155SignatureMinting{
@@ -43,6 +47,9 @@ func newERC1155SignatureMinting(provider *ethclient.Client, address common.Addre
 
 // Mint a token with the data in given payload.
 //
+// The signature is checked before the transaction is sent, and ErrInvalidMintSignature is
+// returned if the payload wasn't signed by a wallet with the minter role.
+//
 // signedPayload: the payload signed by the minters private key being used to mint
 //
 // returns: the transaction receipt of the mint
@@ -52,8 +59,8 @@ func newERC1155SignatureMinting(provider *ethclient.Client, address common.Addre
 //	// Learn more about how to craft a payload in the Generate() function
 //	signedPayload, err := contract.Signature.Generate(payload)
 //	tx, err := contract.Signature.Mint(signedPayload)
-func (signature *ERC1155SignatureMinting) Mint(ctx context.Context, signedPayload *SignedPayload1155) (*types.Transaction, error) {
-	txOpts, err := signature.Helper.GetTxOptions(ctx)
+func (signature *ERC1155SignatureMinting) Mint(ctx context.Context, signedPayload *SignedPayload1155, options ...*TransactionOptions) (*types.Transaction, error) {
+	txOpts, err := signature.Helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -67,6 +74,10 @@ func (signature *ERC1155SignatureMinting) Mint(ctx context.Context, signedPayloa
 }
 
 func (signature *ERC1155SignatureMinting) MintWithOpts(ctx context.Context, signedPayload *SignedPayload1155, txOpts *bind.TransactOpts) (*types.Transaction, error) {
+	if _, err := signature.verifyMintSigner(ctx, signedPayload); err != nil {
+		return nil, err
+	}
+
 	message, err := signature.mapPayloadToContractStruct(ctx, signedPayload.Payload)
 	if err != nil {
 		return nil, err
@@ -101,7 +112,7 @@ func (signature *ERC1155SignatureMinting) MintWithOpts(ctx context.Context, sign
 //	// Learn more about how to craft multiple payloads in the GenerateBatch() function
 //	signedPayloads, err := contract.Signature.GenerateBatch(payloads)
 //	tx, err := contract.Signature.MintBatch(signedPayloads)
-func (signature *ERC1155SignatureMinting) MintBatch(ctx context.Context, signedPayloads []*SignedPayload1155) (*types.Transaction, error) {
+func (signature *ERC1155SignatureMinting) MintBatch(ctx context.Context, signedPayloads []*SignedPayload1155, options ...*TransactionOptions) (*types.Transaction, error) {
 	contractPayloads := []*abi.ITokenERC1155MintRequest{}
 	for _, signedPayload := range signedPayloads {
 		price, ok := big.NewInt(0).SetString(signedPayload.Payload.Price, 10)
@@ -141,7 +152,7 @@ func (signature *ERC1155SignatureMinting) MintBatch(ctx context.Context, signedP
 		encoded = append(encoded, tx.Data())
 	}
 
-	txOpts, err := signature.Helper.GetTxOptions(ctx)
+	txOpts, err := signature.Helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -417,98 +428,116 @@ func (signature *ERC1155SignatureMinting) GenerateBatchFromTokenIds(ctx context.
 			Uid:                  id,
 		}
 
-		mappedPayload := generateMessage(payload)
-
-		typedData := signerTypes.TypedData{
-			Types: signerTypes.Types{
-				"MintRequest": []signerTypes.Type{
-					{Name: "to", Type: "address"},
-					{Name: "royaltyRecipient", Type: "address"},
-					{Name: "royaltyBps", Type: "uint256"},
-					{Name: "primarySaleRecipient", Type: "address"},
-					{Name: "tokenId", Type: "uint256"},
-					{Name: "uri", Type: "string"},
-					{Name: "quantity", Type: "uint256"},
-					{Name: "pricePerToken", Type: "uint256"},
-					{Name: "currency", Type: "address"},
-					{Name: "validityStartTimestamp", Type: "uint128"},
-					{Name: "validityEndTimestamp", Type: "uint128"},
-					{Name: "uid", Type: "bytes32"},
-				},
-				"EIP712Domain": []signerTypes.Type{
-					{Name: "name", Type: "string"},
-					{Name: "version", Type: "string"},
-					{Name: "chainId", Type: "uint256"},
-					{Name: "verifyingContract", Type: "address"},
-				},
-			},
-			PrimaryType: "MintRequest",
-			Domain: signerTypes.TypedDataDomain{
-				Name:              "TokenERC1155",
-				Version:           "1",
-				ChainId:           math.NewHexOrDecimal256(chainId.Int64()),
-				VerifyingContract: signature.Helper.getAddress().String(),
-			},
-			Message: mappedPayload,
-		}
-
-		domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
-		if err != nil {
-			return nil, err
-		}
-
-		typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+		typedData, err := newMintRequest1155TypedData(chainId, signature.Helper.getAddress().String(), payload)
 		if err != nil {
 			return nil, err
 		}
 
-		rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
-		sigHash := crypto.Keccak256(rawData)
-
-		privateKey := signature.Helper.GetPrivateKey()
-		signatureHash, err := crypto.Sign(sigHash, privateKey)
+		signed, err := signMintRequest(typedData, signature.Helper.GetPrivateKey())
 		if err != nil {
 			return nil, err
 		}
 
-		// We need this to correct v = 0,1 to v = 27,28 - or else all will break
-		if signatureHash[64] == 0 || signatureHash[64] == 1 {
-			signatureHash[64] += 27
-		}
-
 		signedPayloads = append(signedPayloads, &SignedPayload1155{
 			Payload:   payload,
-			Signature: "0x" + hex.EncodeToString(signatureHash),
+			Signature: signed,
 		})
 	}
 
 	return signedPayloads, nil
 }
 
-func generateMessage(mintRequest *Signature1155PayloadOutput) signerTypes.TypedDataMessage {
+// The MintRequest struct of TokenERC1155, in the order the contract hashes it
+type mintRequest1155 struct {
+	To                     string   `eip712:"to,address"`
+	RoyaltyRecipient       string   `eip712:"royaltyRecipient,address"`
+	RoyaltyBps             int      `eip712:"royaltyBps,uint256"`
+	PrimarySaleRecipient   string   `eip712:"primarySaleRecipient,address"`
+	TokenId                *big.Int `eip712:"tokenId,uint256"`
+	Uri                    string   `eip712:"uri,string"`
+	Quantity               int      `eip712:"quantity,uint256"`
+	PricePerToken          string   `eip712:"pricePerToken,uint256"`
+	Currency               string   `eip712:"currency,address"`
+	ValidityStartTimestamp int      `eip712:"validityStartTimestamp,uint128"`
+	ValidityEndTimestamp   int      `eip712:"validityEndTimestamp,uint128"`
+	Uid                    [32]byte `eip712:"uid,bytes32"`
+}
+
+func newMintRequest1155TypedData(chainId *big.Int, contractAddress string, payload *Signature1155PayloadOutput) (signerTypes.TypedData, error) {
 	// If tokenID < 0, set it to MaxUin256 (to mint a new NFT)
-	tokenId := big.NewInt(int64(mintRequest.TokenId))
-	if mintRequest.TokenId < 0 {
-		MaxUint256 := new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 256), common.Big1)
-		tokenId = MaxUint256
+	tokenId := big.NewInt(int64(payload.TokenId))
+	if payload.TokenId < 0 {
+		tokenId = new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 256), common.Big1)
 	}
 
-	message := signerTypes.TypedDataMessage{
-		"to":                     mintRequest.To,
-		"royaltyRecipient":       mintRequest.RoyaltyRecipient,
-		"royaltyBps":             fmt.Sprintf("%v", mintRequest.RoyaltyBps),
-		"primarySaleRecipient":   mintRequest.PrimarySaleRecipient,
-		"uri":                    mintRequest.Uri,
-		"pricePerToken":          fmt.Sprintf("%v", mintRequest.Price),
-		"tokenId":                tokenId.String(),
-		"quantity":               fmt.Sprintf("%v", mintRequest.Quantity),
-		"currency":               mintRequest.CurrencyAddress,
-		"validityStartTimestamp": fmt.Sprintf("%v", mintRequest.MintStartTime),
-		"validityEndTimestamp":   fmt.Sprintf("%v", mintRequest.MintEndTime),
-		"uid":                    mintRequest.Uid[:],
+	encoder := NewTypedDataEncoder("TokenERC1155", "1", chainId, contractAddress)
+	return encoder.TypedData("MintRequest", &mintRequest1155{
+		To:                     payload.To,
+		RoyaltyRecipient:       payload.RoyaltyRecipient,
+		RoyaltyBps:             payload.RoyaltyBps,
+		PrimarySaleRecipient:   payload.PrimarySaleRecipient,
+		TokenId:                tokenId,
+		Uri:                    payload.Uri,
+		Quantity:               payload.Quantity,
+		PricePerToken:          payload.Price,
+		Currency:               payload.CurrencyAddress,
+		ValidityStartTimestamp: payload.MintStartTime,
+		ValidityEndTimestamp:   payload.MintEndTime,
+		Uid:                    payload.Uid,
+	})
+}
+
+// Get the signer of a signed payload
+//
+// The signer is recovered from the signature without sending a transaction, and then checked for
+// the minter role on the contract.
+//
+// signedPayload: the payload to get the signer of
+//
+// returns: the address of the signer, or ErrInvalidMintSignature if it doesn't have the minter role
+//
+// Example
+//
+//	// Learn more about how to craft a payload in the Generate() function
+//	signedPayload, err := contract.Signature.Generate(payload)
+//	signer, err := contract.Signature.VerifyMintSignature(context.Background(), signedPayload)
+func (signature *ERC1155SignatureMinting) VerifyMintSignature(ctx context.Context, signedPayload *SignedPayload1155) (string, error) {
+	signer, err := signature.verifyMintSigner(ctx, signedPayload)
+	if err != nil {
+		return "", err
+	}
+
+	return signer.Hex(), nil
+}
+
+// Checks off-chain that the payload was signed by a wallet with the minter role, so an invalid
+// signature fails before a transaction is sent
+func (signature *ERC1155SignatureMinting) verifyMintSigner(ctx context.Context, signedPayload *SignedPayload1155) (common.Address, error) {
+	chainId, err := signature.Helper.GetChainID(ctx)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	typedData, err := newMintRequest1155TypedData(chainId, signature.Helper.getAddress().String(), signedPayload.Payload)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	signer, err := recoverTypedDataSigner(typedData, signedPayload.Signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	minterRole := crypto.Keccak256Hash([]byte("MINTER_ROLE"))
+	isMinter, err := signature.abi.HasRole(&bind.CallOpts{Context: ctx}, minterRole, signer)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if !isMinter {
+		return common.Address{}, fmt.Errorf("%w: %s doesn't have the minter role", ErrInvalidMintSignature, signer.Hex())
 	}
 
-	return message
+	return signer, nil
 }
 
 func (signature *ERC1155SignatureMinting) mapPayloadToContractStruct(ctx context.Context, mintRequest *Signature1155PayloadOutput) (*abi.ITokenERC1155MintRequest, error) {
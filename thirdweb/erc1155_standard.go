@@ -2,10 +2,10 @@ package thirdweb
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 )
 
 // This interface is currently support by the Edition and Edition Drop contracts.
@@ -14,8 +14,8 @@ type ERC1155Standard struct {
 	erc1155 *ERC1155
 }
 
-func newERC1155Standard(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*ERC1155Standard, error) {
-	erc1155, err := newERC1155(provider, address, privateKey, storage)
+func newERC1155Standard(handler *ProviderHandler, address common.Address, storage storage) (*ERC1155Standard, error) {
+	erc1155, err := newERC1155(handler, address, storage)
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +85,13 @@ func (erc1155 *ERC1155Standard) TotalSupply(ctx context.Context, tokenId int) (i
 	return erc1155.erc1155.TotalSupply(ctx, tokenId)
 }
 
+// Get the total number of NFTs across all token IDs.
+//
+// returns: the sum of the supplies of every token ID on the contract
+func (erc1155 *ERC1155Standard) GetTotalCirculatingSupply(ctx context.Context) (*big.Int, error) {
+	return erc1155.erc1155.GetTotalCirculatingSupply(ctx)
+}
+
 // Get the NFT balance of the connected wallet for a specific token ID.
 //
 // tokenId: the token ID of a specific token to check the balance of
@@ -137,8 +144,8 @@ func (erc1155 *ERC1155Standard) IsApproved(ctx context.Context, address string,
 //	amount := 1
 //
 //	tx, err := contract.Transfer(context.Background(), to, tokenId, amount)
-func (erc1155 *ERC1155Standard) Transfer(ctx context.Context, to string, tokenId int, amount int) (*types.Transaction, error) {
-	return erc1155.erc1155.Transfer(ctx, to, tokenId, amount)
+func (erc1155 *ERC1155Standard) Transfer(ctx context.Context, to string, tokenId int, amount int, options ...*TransactionOptions) (*types.Transaction, error) {
+	return erc1155.erc1155.Transfer(ctx, to, tokenId, amount, options...)
 }
 
 // Burn an amount of a specified NFT from the connected wallet.
@@ -154,8 +161,8 @@ func (erc1155 *ERC1155Standard) Transfer(ctx context.Context, to string, tokenId
 //	tokenId := 0
 //	amount := 1
 //	tx, err := contract.Burn(context.Background(), tokenId, amount)
-func (erc1155 *ERC1155Standard) Burn(ctx context.Context, tokenId int, amount int) (*types.Transaction, error) {
-	return erc1155.erc1155.Burn(ctx, tokenId, amount)
+func (erc1155 *ERC1155Standard) Burn(ctx context.Context, tokenId int, amount int, options ...*TransactionOptions) (*types.Transaction, error) {
+	return erc1155.erc1155.Burn(ctx, tokenId, amount, options...)
 }
 
 // Set the approval for all operations of a specific address's assets.
@@ -167,6 +174,6 @@ func (erc1155 *ERC1155Standard) Burn(ctx context.Context, tokenId int, amount in
 // approved: true if the operator is approved for all operations of the assets, otherwise false
 //
 // returns: the transaction receipt of the approval
-func (erc1155 *ERC1155Standard) SetApprovalForAll(ctx context.Context, operator string, approved bool) (*types.Transaction, error) {
-	return erc1155.erc1155.SetApprovalForAll(ctx, operator, approved)
+func (erc1155 *ERC1155Standard) SetApprovalForAll(ctx context.Context, operator string, approved bool, options ...*TransactionOptions) (*types.Transaction, error) {
+	return erc1155.erc1155.SetApprovalForAll(ctx, operator, approved, options...)
 }
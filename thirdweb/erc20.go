@@ -2,12 +2,13 @@ package thirdweb
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/thirdweb-dev/go-sdk/v2/abi"
 )
@@ -20,10 +21,12 @@ type ERC20 struct {
 	storage storage
 }
 
-func newERC20(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*ERC20, error) {
-	if contractAbi, err := abi.NewTokenERC20(address, provider); err != nil {
+func newERC20(handler *ProviderHandler, address common.Address, storage storage) (*ERC20, error) {
+	backend := handler.getBackend()
+
+	if contractAbi, err := abi.NewTokenERC20(address, backend); err != nil {
 		return nil, err
-	} else if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+	} else if helper, err := newContractHelper(address, handler); err != nil {
 		return nil, err
 	} else {
 		return &ERC20{
@@ -76,6 +79,10 @@ func (erc20 *ERC20) Balance(ctx context.Context) (*CurrencyValue, error) {
 // 	balance, err := contract.ERC20.BalanceOf()
 // 	balanceValue := balance.DisplayValue
 func (erc20 *ERC20) BalanceOf(ctx context.Context, address string) (*CurrencyValue, error) {
+	if !erc20.helper.isValidAddress(address) {
+		return nil, ErrInvalidAddress
+	}
+
 	balanceOf, err := erc20.abi.BalanceOf(&bind.CallOpts{Context: ctx}, common.HexToAddress(address))
 	if err != nil {
 		return nil, err
@@ -138,6 +145,10 @@ func (erc20 *ERC20) Allowance(ctx context.Context, spender string) (*CurrencyVal
 //	allowance, err := contract.ERC20.AllowanceOf(address, spender)
 //	allowanceValue := allowance.DisplayValue
 func (erc20 *ERC20) AllowanceOf(ctx context.Context, owner string, spender string) (*CurrencyValue, error) {
+	if !erc20.helper.isValidAddress(owner) || !erc20.helper.isValidAddress(spender) {
+		return nil, ErrInvalidAddress
+	}
+
 	allowance, err := erc20.abi.Allowance(&bind.CallOpts{Context: ctx}, common.HexToAddress(owner), common.HexToAddress(spender))
 	if err != nil {
 		return nil, err
@@ -162,13 +173,17 @@ func (erc20 *ERC20) AllowanceOf(ctx context.Context, owner string, spender strin
 //	amount := 1
 //
 //	tx, err := contract.ERC20.Transfer(context.Background(), to, amount)
-func (erc20 *ERC20) Transfer(ctx context.Context, to string, amount float64) (*types.Transaction, error) {
+func (erc20 *ERC20) Transfer(ctx context.Context, to string, amount float64, options ...*TransactionOptions) (*types.Transaction, error) {
+	if !erc20.helper.isValidAddress(to) {
+		return nil, ErrInvalidAddress
+	}
+
 	amountWithDecimals, err := erc20.normalizeAmount(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := erc20.helper.GetTxOptions(ctx)
+	txOpts, err := erc20.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -199,13 +214,17 @@ func (erc20 *ERC20) Transfer(ctx context.Context, to string, amount float64) (*t
 //	amount := 1
 //
 //	tx, err := contract.ERC20.TransferFrom(context.Background(), from, to, amount)
-func (erc20 *ERC20) TransferFrom(ctx context.Context, from string, to string, amount float64) (*types.Transaction, error) {
+func (erc20 *ERC20) TransferFrom(ctx context.Context, from string, to string, amount float64, options ...*TransactionOptions) (*types.Transaction, error) {
+	if !erc20.helper.isValidAddress(from) || !erc20.helper.isValidAddress(to) {
+		return nil, ErrInvalidAddress
+	}
+
 	amountWithDecimals, err := erc20.normalizeAmount(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := erc20.helper.GetTxOptions(ctx)
+	txOpts, err := erc20.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -233,13 +252,17 @@ func (erc20 *ERC20) TransferFrom(ctx context.Context, from string, to string, am
 //	amount := 1
 //
 //	tx, err := contract.ERC20.SetAllowance(context.Background(), spender, amount)
-func (erc20 *ERC20) SetAllowance(ctx context.Context, spender string, amount float64) (*types.Transaction, error) {
+func (erc20 *ERC20) SetAllowance(ctx context.Context, spender string, amount float64, options ...*TransactionOptions) (*types.Transaction, error) {
+	if !erc20.helper.isValidAddress(spender) {
+		return nil, ErrInvalidAddress
+	}
+
 	amountWithDecimals, err := erc20.normalizeAmount(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := erc20.helper.GetTxOptions(ctx)
+	txOpts, err := erc20.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -271,10 +294,14 @@ func (erc20 *ERC20) SetAllowance(ctx context.Context, spender string, amount flo
 //	}
 //
 //	tx, err := contract.ERC20.TransferBatch(context.Background(), args)
-func (erc20 *ERC20) TransferBatch(ctx context.Context, args []*TokenAmount) (*types.Transaction, error) {
+func (erc20 *ERC20) TransferBatch(ctx context.Context, args []*TokenAmount, options ...*TransactionOptions) (*types.Transaction, error) {
 	encoded := [][]byte{}
 
 	for _, arg := range args {
+		if !erc20.helper.isValidAddress(arg.ToAddress) {
+			return nil, ErrInvalidAddress
+		}
+
 		amountWithDecimals, err := erc20.normalizeAmount(ctx, arg.Amount)
 		if err != nil {
 			return nil, err
@@ -292,7 +319,7 @@ func (erc20 *ERC20) TransferBatch(ctx context.Context, args []*TokenAmount) (*ty
 		encoded = append(encoded, tx.Data())
 	}
 
-	txOpts, err := erc20.helper.GetTxOptions(ctx)
+	txOpts, err := erc20.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -316,13 +343,13 @@ func (erc20 *ERC20) TransferBatch(ctx context.Context, args []*TokenAmount) (*ty
 //
 //	amount := 1
 //	tx, err := contract.ERC20.Burn(context.Background(), amount)
-func (erc20 *ERC20) Burn(ctx context.Context, amount float64) (*types.Transaction, error) {
+func (erc20 *ERC20) Burn(ctx context.Context, amount float64, options ...*TransactionOptions) (*types.Transaction, error) {
 	amountWithDecimals, err := erc20.normalizeAmount(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := erc20.helper.GetTxOptions(ctx)
+	txOpts, err := erc20.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -338,6 +365,8 @@ func (erc20 *ERC20) Burn(ctx context.Context, amount float64) (*types.Transactio
 //
 // @extension: ERC20Burnable
 //
+// The holder must have first approved the connected wallet to spend at least the amount to burn.
+//
 // holder: wallet address to burn the tokens from
 //
 // amount: amount of tokens to burn
@@ -350,13 +379,33 @@ func (erc20 *ERC20) Burn(ctx context.Context, amount float64) (*types.Transactio
 //	amount := 1
 //
 //	tx, err := contract.ERC20.BurnFrom(context.Background(), holder, amount)
-func (erc20 *ERC20) BurnFrom(ctx context.Context, holder string, amount float64) (*types.Transaction, error) {
+func (erc20 *ERC20) BurnFrom(ctx context.Context, holder string, amount float64, options ...*TransactionOptions) (*types.Transaction, error) {
+	if !erc20.helper.isValidAddress(holder) {
+		return nil, ErrInvalidAddress
+	}
+
 	amountWithDecimals, err := erc20.normalizeAmount(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := erc20.helper.GetTxOptions(ctx)
+	allowance, err := erc20.abi.Allowance(
+		&bind.CallOpts{Context: ctx},
+		common.HexToAddress(holder),
+		erc20.helper.GetSignerAddress(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if allowance.Cmp(amountWithDecimals) < 0 {
+		return nil, fmt.Errorf(
+			"Insufficient allowance to burn %v tokens from %s, approve the connected wallet for at least this amount first",
+			amount,
+			holder,
+		)
+	}
+
+	txOpts, err := erc20.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -380,8 +429,8 @@ func (erc20 *ERC20) BurnFrom(ctx context.Context, holder string, amount float64)
 // Example
 //
 // 	tx, err := contract.ERC20.Mint(context.Background(), 1)
-func (erc20 *ERC20) Mint(ctx context.Context, amount float64) (*types.Transaction, error) {
-	return erc20.MintTo(ctx, erc20.helper.GetSignerAddress().String(), amount)
+func (erc20 *ERC20) Mint(ctx context.Context, amount float64, options ...*TransactionOptions) (*types.Transaction, error) {
+	return erc20.MintTo(ctx, erc20.helper.GetSignerAddress().String(), amount, options...)
 }
 
 // Mint tokens to a specific wallet
@@ -397,13 +446,17 @@ func (erc20 *ERC20) Mint(ctx context.Context, amount float64) (*types.Transactio
 // Example
 //
 //	tx, err := contract.ERC20.MintTo(context.Background(), "{{wallet_address}}", 1)
-func (erc20 *ERC20) MintTo(ctx context.Context, to string, amount float64) (*types.Transaction, error) {
+func (erc20 *ERC20) MintTo(ctx context.Context, to string, amount float64, options ...*TransactionOptions) (*types.Transaction, error) {
+	if !erc20.helper.isValidAddress(to) {
+		return nil, ErrInvalidAddress
+	}
+
 	amountWithDecimals, err := erc20.normalizeAmount(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := erc20.helper.GetTxOptions(ctx)
+	txOpts, err := erc20.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -415,6 +468,26 @@ func (erc20 *ERC20) MintTo(ctx context.Context, to string, amount float64) (*typ
 	return erc20.helper.AwaitTx(ctx, tx.Hash())
 }
 
+// Check if a wallet is allowed to mint tokens
+//
+// @extension: ERC20Mintable
+//
+// address: wallet address to check the minter role of
+//
+// returns: true if the wallet has the minter role on the contract
+//
+// Example
+//
+//	canMint, err := contract.ERC20.CanMint(context.Background(), "{{wallet_address}}")
+func (erc20 *ERC20) CanMint(ctx context.Context, address string) (bool, error) {
+	if !erc20.helper.isValidAddress(address) {
+		return false, ErrInvalidAddress
+	}
+
+	minterRole := crypto.Keccak256Hash([]byte("MINTER_ROLE"))
+	return erc20.abi.HasRole(&bind.CallOpts{Context: ctx}, minterRole, common.HexToAddress(address))
+}
+
 // Mint tokens to many wallets
 //
 // @extension: ERC20BatchMintable
@@ -437,10 +510,14 @@ func (erc20 *ERC20) MintTo(ctx context.Context, to string, amount float64) (*typ
 //	}
 //
 //	tx, err := contract.ERC20.MintBatchTo(context.Background(), args)
-func (erc20 *ERC20) MintBatchTo(ctx context.Context, args []*TokenAmount) (*types.Transaction, error) {
+func (erc20 *ERC20) MintBatchTo(ctx context.Context, args []*TokenAmount, options ...*TransactionOptions) (*types.Transaction, error) {
 	encoded := [][]byte{}
 
 	for _, arg := range args {
+		if !erc20.helper.isValidAddress(arg.ToAddress) {
+			return nil, ErrInvalidAddress
+		}
+
 		amountWithDecimals, err := erc20.normalizeAmount(ctx, arg.Amount)
 		if err != nil {
 			return nil, err
@@ -458,7 +535,7 @@ func (erc20 *ERC20) MintBatchTo(ctx context.Context, args []*TokenAmount) (*type
 		encoded = append(encoded, tx.Data())
 	}
 
-	txOpts, err := erc20.helper.GetTxOptions(ctx)
+	txOpts, err := erc20.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
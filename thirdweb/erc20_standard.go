@@ -5,7 +5,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 )
 
 // This interface is currently support by the Token contract. You can access
@@ -14,8 +13,8 @@ type ERC20Standard struct {
 	erc20 *ERC20
 }
 
-func newERC20Standard(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*ERC20Standard, error) {
-	erc20, err := newERC20(provider, address, privateKey, storage)
+func newERC20Standard(handler *ProviderHandler, address common.Address, storage storage) (*ERC20Standard, error) {
+	erc20, err := newERC20(handler, address, storage)
 	if err != nil {
 		return nil, err
 	}
@@ -120,8 +119,8 @@ func (erc20 *ERC20Standard) AllowanceOf(ctx context.Context, owner string, spend
 //	amount := 1
 //
 //	tx, err := contract.Transfer(context.Background(), to, amount)
-func (erc20 *ERC20Standard) Transfer(ctx context.Context, to string, amount float64) (*types.Transaction, error) {
-	return erc20.erc20.Transfer(ctx, to, amount)
+func (erc20 *ERC20Standard) Transfer(ctx context.Context, to string, amount float64, options ...*TransactionOptions) (*types.Transaction, error) {
+	return erc20.erc20.Transfer(ctx, to, amount, options...)
 }
 
 // Transfer a specified amount of tokens from one specified address to another.
@@ -141,8 +140,8 @@ func (erc20 *ERC20Standard) Transfer(ctx context.Context, to string, amount floa
 //	amount := 1
 //
 //	tx, err := contract.TransferFrom(context.Background(), from, to, amount)
-func (erc20 *ERC20Standard) TransferFrom(ctx context.Context, from string, to string, amount float64) (*types.Transaction, error) {
-	return erc20.erc20.TransferFrom(ctx, from, to, amount)
+func (erc20 *ERC20Standard) TransferFrom(ctx context.Context, from string, to string, amount float64, options ...*TransactionOptions) (*types.Transaction, error) {
+	return erc20.erc20.TransferFrom(ctx, from, to, amount, options...)
 }
 
 // Sets the allowance of a wallet to spend the connected wallets funds.
@@ -159,8 +158,8 @@ func (erc20 *ERC20Standard) TransferFrom(ctx context.Context, from string, to st
 //	amount := 1
 //
 //	tx, err := contract.SetAllowance(context.Background(), spender, amount)
-func (erc20 *ERC20Standard) SetAllowance(ctx context.Context, spender string, amount float64) (*types.Transaction, error) {
-	return erc20.erc20.SetAllowance(ctx, spender, amount)
+func (erc20 *ERC20Standard) SetAllowance(ctx context.Context, spender string, amount float64, options ...*TransactionOptions) (*types.Transaction, error) {
+	return erc20.erc20.SetAllowance(ctx, spender, amount, options...)
 }
 
 // Transfer tokens from the connected wallet to many wallets.
@@ -183,8 +182,8 @@ func (erc20 *ERC20Standard) SetAllowance(ctx context.Context, spender string, am
 //	}
 //
 //	tx, err := contract.TransferBatch(context.Background(), args)
-func (erc20 *ERC20Standard) TransferBatch(ctx context.Context, args []*TokenAmount) (*types.Transaction, error) {
-	return erc20.erc20.TransferBatch(ctx, args)
+func (erc20 *ERC20Standard) TransferBatch(ctx context.Context, args []*TokenAmount, options ...*TransactionOptions) (*types.Transaction, error) {
+	return erc20.erc20.TransferBatch(ctx, args, options...)
 }
 
 // Burn a specified amount of tokens from the connected wallet.
@@ -197,8 +196,8 @@ func (erc20 *ERC20Standard) TransferBatch(ctx context.Context, args []*TokenAmou
 //
 //	amount := 1
 //	tx, err := contract.Burn(context.Background(), amount)
-func (erc20 *ERC20Standard) Burn(ctx context.Context, amount float64) (*types.Transaction, error) {
-	return erc20.erc20.Burn(ctx, amount)
+func (erc20 *ERC20Standard) Burn(ctx context.Context, amount float64, options ...*TransactionOptions) (*types.Transaction, error) {
+	return erc20.erc20.Burn(ctx, amount, options...)
 }
 
 // Burn a specified amount of tokens from a specific wallet.
@@ -215,6 +214,6 @@ func (erc20 *ERC20Standard) Burn(ctx context.Context, amount float64) (*types.Tr
 //	amount := 1
 //
 //	tx, err := contract.BurnFrom(context.Background(), holder, amount)
-func (erc20 *ERC20Standard) BurnFrom(ctx context.Context, holder string, amount float64) (*types.Transaction, error) {
-	return erc20.erc20.BurnFrom(ctx, holder, amount)
+func (erc20 *ERC20Standard) BurnFrom(ctx context.Context, holder string, amount float64, options ...*TransactionOptions) (*types.Transaction, error) {
+	return erc20.erc20.BurnFrom(ctx, holder, amount, options...)
 }
\ No newline at end of file
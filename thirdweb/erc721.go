@@ -7,12 +7,13 @@ import (
 	"math/big"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/mitchellh/mapstructure"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/thirdweb-dev/go-sdk/v2/abi"
 )
@@ -25,6 +26,10 @@ type ERC721 struct {
 	helper  		*contractHelper
 	storage 		storage
 	ClaimConditions *NFTDropClaimConditions
+	ownership       *erc721Ownership
+	ownedRequests   *singleflight.Group
+	// Number of NFTs fetched concurrently when listing claimed and unclaimed NFTs
+	pageSize int
 }
 
 type NFTResult struct {
@@ -32,23 +37,25 @@ type NFTResult struct {
 	err error
 }
 
-func newERC721(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*ERC721, error) {
-	token, err := abi.NewTokenERC721(address, provider)
+func newERC721(handler *ProviderHandler, address common.Address, storage storage) (*ERC721, error) {
+	backend := handler.getBackend()
+
+	token, err := abi.NewTokenERC721(address, backend)
 	if err != nil {
 		return nil, err
 	}
 
-	drop, err := abi.NewDropERC721(address, provider)
+	drop, err := abi.NewDropERC721(address, backend)
 	if err != nil {
 		return nil, err
 	}
 	
-	helper, err := newContractHelper(address, provider, privateKey)
+	helper, err := newContractHelper(address, handler)
 	if err != nil {
 		return nil, err
 	}
 
-	claimConditions, err := newNFTDropClaimConditions(address, provider, helper, storage)
+	claimConditions, err := newNFTDropClaimConditions(address, backend, helper, storage)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +66,9 @@ func newERC721(provider *ethclient.Client, address common.Address, privateKey st
 		helper,
 		storage,
 		claimConditions,
+		&erc721Ownership{owners: map[string]common.Address{}},
+		&singleflight.Group{},
+		defaultNFTPageSize,
 	}, nil
 }
 
@@ -115,6 +125,195 @@ func (erc721 *ERC721) GetAll(ctx context.Context) ([]*NFTMetadataOwner, error) {
 	}
 }
 
+// Get the metadatas of all the NFTs owned by a specific address.
+//
+// @extension: ERC721
+//
+// Contracts that implement ERC721Enumerable are asked for the tokens of the owner directly. For
+// other contracts, the owner of every token is rebuilt from the Transfer events of the contract.
+// The first call scans from the deployment block, which needs an RPC that serves historical state,
+// and later calls only scan the new blocks.
+//
+// Concurrent calls for the same address share a single set of RPC calls, which keeps running
+// when a caller's context is cancelled so the other callers still get the result.
+//
+// address: the address of the owner of the NFTs, defaults to the connected wallet if empty
+//
+// returns: the metadata of all the NFTs owned by the address
+//
+// Example
+//
+//	nfts, err := contract.ERC721.GetOwned(context.Background(), "{{wallet_address}}")
+//	name := nfts[0].Metadata.Name
+func (erc721 *ERC721) GetOwned(ctx context.Context, address string) ([]*NFTMetadataOwner, error) {
+	if address == "" {
+		address = erc721.helper.GetSignerAddress().String()
+	}
+
+	result := erc721.ownedRequests.DoChan(common.HexToAddress(address).Hex(), func() (interface{}, error) {
+		// Shared by every caller, so no single caller can cancel it, but it still gets a deadline
+		sharedCtx, cancel := context.WithTimeout(context.Background(), erc721.helper.sharedRequestTimeout)
+		defer cancel()
+
+		tokenIds, err := erc721.GetOwnedTokenIDs(sharedCtx, address)
+		if err != nil {
+			return nil, err
+		}
+		return erc721.fetchNFTsByTokenId(sharedCtx, tokenIds)
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-result:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+
+		// Each caller gets its own copy, so callers can't see each other's changes
+		shared := res.Val.([]*NFTMetadataOwner)
+		nfts := make([]*NFTMetadataOwner, len(shared))
+		for i, nft := range shared {
+			copied := *nft
+			copied.Metadata = copyNFTMetadata(nft.Metadata)
+			nfts[i] = &copied
+		}
+		return nfts, nil
+	}
+}
+
+// Get the tokenIds of all the NFTs owned by a specific address.
+//
+// @extension: ERC721
+//
+// address: the address of the owner of the NFTs, defaults to the connected wallet if empty
+//
+// returns: the tokenIds of all the NFTs owned by the address
+func (erc721 *ERC721) GetOwnedTokenIDs(ctx context.Context, address string) ([]*big.Int, error) {
+	if address == "" {
+		address = erc721.helper.GetSignerAddress().String()
+	}
+
+	if erc721.isEnumerable(ctx) {
+		return erc721.getOwnedTokenIDsByIndex(ctx, common.HexToAddress(address))
+	}
+
+	if err := erc721.syncOwnership(ctx); err != nil {
+		return nil, err
+	}
+
+	return erc721.ownership.tokensOf(common.HexToAddress(address)), nil
+}
+
+// Get the metadatas of all the NFTs owned by a specific address, without looking up their owner.
+//
+// @extension: ERC721
+//
+// Like GetOwned, this uses tokenOfOwnerByIndex if the contract implements ERC721Enumerable and
+// falls back to scanning Transfer events otherwise.
+//
+// address: the address of the owner of the NFTs, defaults to the connected wallet if empty
+//
+// returns: the metadata of all the NFTs owned by the address
+//
+// Example
+//
+//	nfts, err := contract.ERC721.GetOwnedWithEnumerable(context.Background(), "{{wallet_address}}")
+//	name := nfts[0].Name
+func (erc721 *ERC721) GetOwnedWithEnumerable(ctx context.Context, address string) ([]*NFTMetadata, error) {
+	tokenIds, err := erc721.GetOwnedTokenIDs(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	nfts := make([]*NFTMetadata, len(tokenIds))
+	errs := make([]error, len(tokenIds))
+	var wg sync.WaitGroup
+	for i, tokenId := range tokenIds {
+		i, tokenId := i, int(tokenId.Int64())
+		wg.Add(1)
+		erc721.helper.concurrency.Go(func() {
+			defer wg.Done()
+			nfts[i], errs[i] = erc721.getTokenMetadata(ctx, tokenId)
+		})
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return nfts, nil
+}
+
+// Get the metadatas of the NFTs that match a filter.
+//
+// @extension: ERC721
+//
+// The owner is checked on-chain with ownerOf before any metadata is fetched, and the attribute
+// filter is applied to the fetched metadata.
+//
+// filter: the owner, token IDs, attribute and maximum number of results to filter by
+//
+// returns: the metadata of the matching NFTs, in the order of the token IDs
+//
+// Example
+//
+//	nfts, err := contract.ERC721.GetAllWithFilter(context.Background(), &thirdweb.NFTFilter{
+//		Owner:        "{{wallet_address}}",
+//		HasAttribute: &thirdweb.AttributeFilter{TraitType: "rarity", Value: "legendary"},
+//		MaxResults:   10,
+//	})
+func (erc721 *ERC721) GetAllWithFilter(ctx context.Context, filter *NFTFilter) ([]*NFTMetadata, error) {
+	if filter == nil {
+		filter = &NFTFilter{}
+	}
+
+	tokenIds := filter.TokenIds
+	if tokenIds == nil {
+		totalCount, err := erc721.GetTotalCount(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < totalCount; i++ {
+			tokenIds = append(tokenIds, i)
+		}
+	}
+
+	if filter.Owner != "" {
+		if !erc721.helper.isValidAddress(filter.Owner) {
+			return nil, ErrInvalidAddress
+		}
+		owner := common.HexToAddress(filter.Owner)
+
+		owned := make([]bool, len(tokenIds))
+		var wg sync.WaitGroup
+		for i, tokenId := range tokenIds {
+			i, tokenId := i, tokenId
+			wg.Add(1)
+			erc721.helper.concurrency.Go(func() {
+				defer wg.Done()
+				// Burned or unminted tokens revert, which counts as not being owned
+				address, err := erc721.token.OwnerOf(&bind.CallOpts{Context: ctx}, big.NewInt(int64(tokenId)))
+				owned[i] = err == nil && address == owner
+			})
+		}
+		wg.Wait()
+
+		ownedTokenIds := []int{}
+		for i, tokenId := range tokenIds {
+			if owned[i] {
+				ownedTokenIds = append(ownedTokenIds, tokenId)
+			}
+		}
+		tokenIds = ownedTokenIds
+	}
+
+	return fetchFilteredNFTs(ctx, erc721.helper.concurrency, tokenIds, filter, erc721.getTokenMetadata)
+}
+
 // Get the total number of NFTs
 //
 // @extension: ERC721ClaimCustom | ERC721ClaimPhasesV2 | ERC721ClaimConditionsV2
@@ -142,19 +341,30 @@ func (erc721 *ERC721) GetTotalCount(ctx context.Context) (int, error) {
 //	claimedNfts, err := contract.ERC721.GetAllClaimed(context.Background())
 //	firstOwner := claimedNfts[0].Owner
 func (erc721 *ERC721) GetAllClaimed(ctx context.Context) ([]*NFTMetadataOwner, error) {
-	if maxId, err := erc721.drop.NextTokenIdToClaim(&bind.CallOpts{Context: ctx}); err != nil {
+	maxId, err := erc721.drop.NextTokenIdToClaim(&bind.CallOpts{Context: ctx})
+	if err != nil {
 		return nil, err
-	} else {
-		nfts := []*NFTMetadataOwner{}
+	}
 
-		for i := 0; i < int(maxId.Int64()); i++ {
-			if nft, err := erc721.Get(ctx, i); err == nil {
-				nfts = append(nfts, nft)
-			}
+	total := int(maxId.Int64())
+	results := make([]*NFTMetadataOwner, total)
+	err = forEachTokenInPages(ctx, erc721.helper.concurrency, 0, total, erc721.pageSize, func(ctx context.Context, tokenId int) {
+		if nft, err := erc721.Get(ctx, tokenId); err == nil {
+			results[tokenId] = nft
 		}
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		return nfts, nil
+	nfts := []*NFTMetadataOwner{}
+	for _, nft := range results {
+		if nft != nil {
+			nfts = append(nfts, nft)
+		}
 	}
+
+	return nfts, nil
 }
 
 // Get all unclaimed NFTs
@@ -175,9 +385,25 @@ func (erc721 *ERC721) GetAllUnclaimed(ctx context.Context) ([]*NFTMetadata, erro
 		return nil, err
 	}
 
+	start := int(unmintedId.Int64())
+	end := int(maxId.Int64())
+	if end < start {
+		return []*NFTMetadata{}, nil
+	}
+
+	results := make([]*NFTMetadata, end-start)
+	err = forEachTokenInPages(ctx, erc721.helper.concurrency, start, end, erc721.pageSize, func(ctx context.Context, tokenId int) {
+		if nft, err := erc721.getTokenMetadata(ctx, tokenId); err == nil {
+			results[tokenId-start] = nft
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	nfts := []*NFTMetadata{}
-	for i := int(unmintedId.Int64()); i < int(maxId.Int64()); i++ {
-		if nft, err := erc721.getTokenMetadata(ctx, i); err == nil {
+	for _, nft := range results {
+		if nft != nil {
 			nfts = append(nfts, nft)
 		}
 	}
@@ -185,6 +411,20 @@ func (erc721 *ERC721) GetAllUnclaimed(ctx context.Context) ([]*NFTMetadata, erro
 	return nfts, nil
 }
 
+// Set how many NFTs GetAllClaimed and GetAllUnclaimed fetch concurrently, defaults to 50. Lower it
+// if your RPC or IPFS gateway rate limits you.
+//
+// pageSize: the number of NFTs to fetch at a time
+//
+// Example
+//
+//	contract.ERC721.SetPageSize(10)
+func (erc721 *ERC721) SetPageSize(pageSize int) {
+	if pageSize > 0 {
+		erc721.pageSize = pageSize
+	}
+}
+
 // Get the number of claimed NFTs
 //
 // @extension: ERC721ClaimCustom | ERC721ClaimPhasesV2 | ERC721ClaimConditionsV2
@@ -245,6 +485,102 @@ func (erc721 *ERC721) OwnerOf(ctx context.Context, tokenId int) (string, error)
 	}
 }
 
+// Verify an NFT
+//
+// @extension: ERC721
+//
+// Checks that the NFT exists, that it's owned by the given address and that its metadata URI
+// resolves to valid JSON.
+//
+// tokenId: the token ID of the NFT to verify
+//
+// ownerAddress: the address that should own the NFT
+//
+// returns: true if every check passes, false if any check fails
+//
+// Example
+//
+//	tokenId := 0
+//	ok, err := contract.ERC721.Verify(context.Background(), tokenId, "{{wallet_address}}")
+func (erc721 *ERC721) Verify(ctx context.Context, tokenId int, ownerAddress string) (bool, error) {
+	if !erc721.helper.isValidAddress(ownerAddress) {
+		return false, ErrInvalidAddress
+	}
+
+	owner, err := erc721.token.OwnerOf(&bind.CallOpts{Context: ctx}, big.NewInt(int64(tokenId)))
+	if err != nil {
+		if isRevertError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if owner != common.HexToAddress(ownerAddress) {
+		return false, nil
+	}
+
+	uri, err := erc721.token.TokenURI(&bind.CallOpts{Context: ctx}, big.NewInt(int64(tokenId)))
+	if err != nil {
+		return false, err
+	}
+
+	return hasValidMetadata(ctx, tokenId, uri, erc721.storage)
+}
+
+// Verify NFT ownership
+//
+// @extension: ERC721
+//
+// address: the address of the wallet to check
+//
+// tokenId: the token ID of the NFT to check
+//
+// returns: true if the wallet owns the NFT, false if it doesn't or the NFT doesn't exist
+//
+// Example
+//
+//	tokenId := 0
+//	ok, err := contract.ERC721.VerifyOwnership(context.Background(), "{{wallet_address}}", tokenId)
+func (erc721 *ERC721) VerifyOwnership(ctx context.Context, address string, tokenId int) (bool, error) {
+	if !erc721.helper.isValidAddress(address) {
+		return false, ErrInvalidAddress
+	}
+
+	owner, err := erc721.token.OwnerOf(&bind.CallOpts{Context: ctx}, big.NewInt(int64(tokenId)))
+	if err != nil {
+		if isRevertError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	// Comparing the parsed addresses ignores the case of the hex string
+	return owner == common.HexToAddress(address), nil
+}
+
+// Verify a wallet owns any NFT
+//
+// @extension: ERC721
+//
+// address: the address of the wallet to check
+//
+// returns: true if the wallet owns at least one NFT of the contract, otherwise false
+//
+// Example
+//
+//	ok, err := contract.ERC721.VerifyOwnsAny(context.Background(), "{{wallet_address}}")
+func (erc721 *ERC721) VerifyOwnsAny(ctx context.Context, address string) (bool, error) {
+	if !erc721.helper.isValidAddress(address) {
+		return false, ErrInvalidAddress
+	}
+
+	balance, err := erc721.token.BalanceOf(&bind.CallOpts{Context: ctx}, common.HexToAddress(address))
+	if err != nil {
+		return false, err
+	}
+
+	return balance.Sign() > 0, nil
+}
+
 // Get the total number of NFTs
 //
 // @extension: ERC721
@@ -505,8 +841,8 @@ func (erc721 *ERC721) GetClaimIneligibilityReasons(ctx context.Context, quantity
 //	tokenId := 0
 //
 //	tx, err := contract.ERC721.Transfer(context.Background(), to, tokenId)
-func (erc721 *ERC721) Transfer(ctx context.Context, to string, tokenId int) (*types.Transaction, error) {
-	txOpts, err := erc721.helper.GetTxOptions(ctx)
+func (erc721 *ERC721) Transfer(ctx context.Context, to string, tokenId int, options ...*TransactionOptions) (*types.Transaction, error) {
+	txOpts, err := erc721.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -529,8 +865,8 @@ func (erc721 *ERC721) Transfer(ctx context.Context, to string, tokenId int) (*ty
 //
 //	tokenId := 0
 //	tx, err := contract.ERC721.Burn(context.Background(), tokenId)
-func (erc721 *ERC721) Burn(ctx context.Context, tokenId int) (*types.Transaction, error) {
-	txOpts, err := erc721.helper.GetTxOptions(ctx)
+func (erc721 *ERC721) Burn(ctx context.Context, tokenId int, options ...*TransactionOptions) (*types.Transaction, error) {
+	txOpts, err := erc721.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -559,8 +895,8 @@ func (erc721 *ERC721) Burn(ctx context.Context, tokenId int) (*types.Transaction
 // 	approved := true
 //
 // 	tx, err := contract.ERC721.SetApprovalForAll(context.Background(), operator, approved)
-func (erc721 *ERC721) SetApprovalForAll(ctx context.Context, operator string, approved bool) (*types.Transaction, error) {
-	txOpts, err := erc721.helper.GetTxOptions(ctx)
+func (erc721 *ERC721) SetApprovalForAll(ctx context.Context, operator string, approved bool, options ...*TransactionOptions) (*types.Transaction, error) {
+	txOpts, err := erc721.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -571,6 +907,36 @@ func (erc721 *ERC721) SetApprovalForAll(ctx context.Context, operator string, ap
 	}
 }
 
+// Set approval for all NFTs for several operators
+//
+// @extension: ERC721
+//
+// Each operator needs its own transaction. The transactions are sent concurrently and all of them
+// are awaited before returning.
+//
+// operators: the addresses of the operators to set the approval for
+//
+// approved: true to approve all NFTs, false to remove the approvals
+//
+// returns: the transaction receipts of the approvals, in the same order as the operators. If some
+// approvals fail, the others are still returned, with nil for the failed ones, along with the error.
+//
+// Example
+//
+//	operators := []string{"{{wallet_address}}", "{{wallet_address}}"}
+//	txs, err := contract.ERC721.SetApprovalForAllBatch(context.Background(), operators, true)
+func (erc721 *ERC721) SetApprovalForAllBatch(ctx context.Context, operators []string, approved bool) ([]*types.Transaction, error) {
+	for _, operator := range operators {
+		if !erc721.helper.isValidAddress(operator) {
+			return nil, ErrInvalidAddress
+		}
+	}
+
+	return erc721.helper.sendParallel(ctx, len(operators), func(ctx context.Context, index int, options *TransactionOptions) (*types.Transaction, error) {
+		return erc721.SetApprovalForAll(ctx, operators[index], approved, options)
+	})
+}
+
 // Set approval for a specific NFT
 //
 // @extension: ERC721
@@ -588,8 +954,8 @@ func (erc721 *ERC721) SetApprovalForAll(ctx context.Context, operator string, ap
 // 	tokenId := 0
 //
 // 	tx, err := contract.ERC721.SetApprovalForToken(context.Background(), operator, approved, tokenId)
-func (erc721 *ERC721) SetApprovalForToken(ctx context.Context, operator string, tokenId int) (*types.Transaction, error) {
-	txOpts, err := erc721.helper.GetTxOptions(ctx)
+func (erc721 *ERC721) SetApprovalForToken(ctx context.Context, operator string, tokenId int, options ...*TransactionOptions) (*types.Transaction, error) {
+	txOpts, err := erc721.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -621,9 +987,9 @@ func (erc721 *ERC721) SetApprovalForToken(ctx context.Context, operator string,
 //	}
 //
 // 	tx, err := contract.ERC721.Mint(context.Background(), metadata)
-func (erc721 *ERC721) Mint(ctx context.Context, metadata *NFTMetadataInput) (*types.Transaction, error) {
+func (erc721 *ERC721) Mint(ctx context.Context, metadata *NFTMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
 	address := erc721.helper.GetSignerAddress().String()
-	return erc721.MintTo(ctx, address, metadata)
+	return erc721.MintTo(ctx, address, metadata, options...)
 }
 
 // Mint an NFT to a specific wallet
@@ -648,13 +1014,13 @@ func (erc721 *ERC721) Mint(ctx context.Context, metadata *NFTMetadataInput) (*ty
 //	}
 //
 //	tx, err := contract.ERC721.MintTo(context.Background(), "{{wallet_address}}", metadata)
-func (erc721 *ERC721) MintTo(ctx context.Context, address string, metadata *NFTMetadataInput) (*types.Transaction, error) {
+func (erc721 *ERC721) MintTo(ctx context.Context, address string, metadata *NFTMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
 	uri, err := uploadOrExtractUri(ctx, metadata, erc721.storage)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := erc721.helper.GetTxOptions(ctx)
+	txOpts, err := erc721.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -692,9 +1058,9 @@ func (erc721 *ERC721) MintTo(ctx context.Context, address string, metadata *NFTM
 //	}
 //
 //	tx, err := contract.ERC721.MintBatchTo(context.Background(), metadatas)
-func (erc721 *ERC721) MintBatch(ctx context.Context, metadatas []*NFTMetadataInput) (*types.Transaction, error) {
+func (erc721 *ERC721) MintBatch(ctx context.Context, metadatas []*NFTMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
 	address := erc721.helper.GetSignerAddress().String()
-	return erc721.MintBatchTo(ctx, address, metadatas)
+	return erc721.MintBatchTo(ctx, address, metadatas, options...)
 }
 
 // Mint many NFTs to a specific wallet
@@ -721,7 +1087,7 @@ func (erc721 *ERC721) MintBatch(ctx context.Context, metadatas []*NFTMetadataInp
 //	}
 //
 //	tx, err := contract.ERC721.MintBatchTo(context.Background(), "{{wallet_address}}", metadatas)
-func (erc721 *ERC721) MintBatchTo(ctx context.Context, address string, metadatas []*NFTMetadataInput) (*types.Transaction, error) {
+func (erc721 *ERC721) MintBatchTo(ctx context.Context, address string, metadatas []*NFTMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
 	uris, err := uploadOrExtractUris(ctx, metadatas, erc721.storage)
 	if err != nil {
 		return nil, err
@@ -745,7 +1111,7 @@ func (erc721 *ERC721) MintBatchTo(ctx context.Context, address string, metadatas
 		encoded = append(encoded, tx.Data())
 	}
 
-	txOpts, err := erc721.helper.GetTxOptions(ctx)
+	txOpts, err := erc721.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -787,7 +1153,7 @@ func (erc721 *ERC721) MintBatchTo(ctx context.Context, address string, metadatas
 //	}
 //
 //	tx, err := contract.ERC721.CreateBatch(context.Background(), metadatas)
-func (erc721 *ERC721) CreateBatch(ctx context.Context, metadatas []*NFTMetadataInput) (*types.Transaction, error) {
+func (erc721 *ERC721) CreateBatch(ctx context.Context, metadatas []*NFTMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
 	startNumber, err := erc721.drop.NextTokenIdToMint(&bind.CallOpts{Context: ctx})
 	if err != nil {
 		return nil, err
@@ -815,7 +1181,7 @@ func (erc721 *ERC721) CreateBatch(ctx context.Context, metadatas []*NFTMetadataI
 		signerAddress,
 	)
 
-	txOpts, err := erc721.helper.GetTxOptions(ctx)
+	txOpts, err := erc721.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -845,9 +1211,9 @@ func (erc721 *ERC721) CreateBatch(ctx context.Context, metadatas []*NFTMetadataI
 //	quantity = 1
 //
 //	tx, err := contract.ERC721.Claim(context.Background(), quantity)
-func (erc721 *ERC721) Claim(ctx context.Context, quantity int) (*types.Transaction, error) {
+func (erc721 *ERC721) Claim(ctx context.Context, quantity int, options ...*TransactionOptions) (*types.Transaction, error) {
 	address := erc721.helper.GetSignerAddress().String()
-	return erc721.ClaimTo(ctx, address, quantity)
+	return erc721.ClaimTo(ctx, address, quantity, options...)
 }
 
 // Claim NFTs to a specific wallet
@@ -866,7 +1232,7 @@ func (erc721 *ERC721) Claim(ctx context.Context, quantity int) (*types.Transacti
 //	quantity = 1
 //
 //	tx, err := contract.ERC721.ClaimTo(context.Background(), address, quantity)
-func (erc721 *ERC721) ClaimTo(ctx context.Context, destinationAddress string, quantity int) (*types.Transaction, error) {
+func (erc721 *ERC721) ClaimTo(ctx context.Context, destinationAddress string, quantity int, options ...*TransactionOptions) (*types.Transaction, error) {
 	addressToClaim := erc721.helper.GetSignerAddress().Hex()
 
 	claimVerification, err := erc721.prepareClaim(ctx, addressToClaim, quantity, true)
@@ -874,7 +1240,7 @@ func (erc721 *ERC721) ClaimTo(ctx context.Context, destinationAddress string, qu
 		return nil, err
 	}
 
-	txOpts, err := erc721.helper.GetTxOptions(ctx)
+	txOpts, err := erc721.helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -949,20 +1315,74 @@ func (erc721 *ERC721) getTokenMetadata(ctx context.Context, tokenId int) (*NFTMe
 	}
 }
 
+// Contracts that don't implement ERC165 revert, which counts as not being enumerable
+func (erc721 *ERC721) isEnumerable(ctx context.Context) bool {
+	enumerable, err := erc721.token.SupportsInterface(&bind.CallOpts{Context: ctx}, [4]byte{0x78, 0x0E, 0x9D, 0x63})
+	return err == nil && enumerable
+}
+
+func (erc721 *ERC721) getOwnedTokenIDsByIndex(ctx context.Context, owner common.Address) ([]*big.Int, error) {
+	balance, err := erc721.token.BalanceOf(&bind.CallOpts{Context: ctx}, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIds := []*big.Int{}
+	for i := int64(0); i < balance.Int64(); i++ {
+		tokenId, err := erc721.token.TokenOfOwnerByIndex(&bind.CallOpts{Context: ctx}, owner, big.NewInt(i))
+		if err != nil {
+			return nil, err
+		}
+		tokenIds = append(tokenIds, tokenId)
+	}
+
+	return tokenIds, nil
+}
+
+// Calls fetch for every token ID from start to end, with at most pageSize calls running at a time,
+// each of them also taking a slot of the limiter
+func forEachTokenInPages(ctx context.Context, limiter *concurrencyLimiter, start int, end int, pageSize int, fetch func(ctx context.Context, tokenId int)) error {
+	for pageStart := start; pageStart < end; pageStart += pageSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		pageEnd := pageStart + pageSize
+		if pageEnd > end {
+			pageEnd = end
+		}
+
+		var wg sync.WaitGroup
+		for tokenId := pageStart; tokenId < pageEnd; tokenId++ {
+			tokenId := tokenId
+			wg.Add(1)
+			limiter.Go(func() {
+				defer wg.Done()
+				fetch(ctx, tokenId)
+			})
+		}
+		wg.Wait()
+	}
+
+	return nil
+}
+
 func (erc721 *ERC721) fetchNFTsByTokenId(ctx context.Context, tokenIds []*big.Int) ([]*NFTMetadataOwner, error) {
 	total := len(tokenIds)
 
-	ch := make(chan *NFTResult)
+	// Buffered so the goroutines can finish while later ones are still waiting for a slot
+	ch := make(chan *NFTResult, total)
 	// fetch all nfts in parallel
 	for i := 0; i < total; i++ {
-		go func(id int) {
+		id := int(tokenIds[i].Int64())
+		erc721.helper.concurrency.Go(func() {
 			if nft, err := erc721.Get(ctx, id); err == nil {
 				ch <- &NFTResult{nft, nil}
 			} else {
 				fmt.Println(err)
 				ch <- &NFTResult{nil, err}
 			}
-		}(i)
+		})
 	}
 	// wait for all goroutines to emit
 	results := make([]*NFTResult, total)
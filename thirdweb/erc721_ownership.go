@@ -0,0 +1,117 @@
+package thirdweb
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The owner of every token of an ERC721 contract, rebuilt from its Transfer events. It's kept
+// between calls so that only the blocks since the last scan need to be fetched again.
+type erc721Ownership struct {
+	mu     sync.Mutex
+	owners map[string]common.Address
+	// Number of tokens minted and burned in the scanned blocks
+	minted uint64
+	burned uint64
+	// First block that hasn't been scanned yet, zero until the deployment block is known
+	nextBlock uint64
+}
+
+func (ownership *erc721Ownership) tokensOf(owner common.Address) []*big.Int {
+	ownership.mu.Lock()
+	defer ownership.mu.Unlock()
+
+	tokenIds := []*big.Int{}
+	for id, tokenOwner := range ownership.owners {
+		if tokenOwner == owner {
+			tokenId, _ := new(big.Int).SetString(id, 10)
+			tokenIds = append(tokenIds, tokenId)
+		}
+	}
+
+	sort.Slice(tokenIds, func(i, j int) bool {
+		return tokenIds[i].Cmp(tokenIds[j]) < 0
+	})
+	return tokenIds
+}
+
+func (ownership *erc721Ownership) stats() *CollectionStats {
+	ownership.mu.Lock()
+	defer ownership.mu.Unlock()
+
+	owners := map[common.Address]bool{}
+	for _, owner := range ownership.owners {
+		owners[owner] = true
+	}
+
+	return &CollectionStats{
+		TotalMinted:       new(big.Int).SetUint64(ownership.minted),
+		TotalBurned:       new(big.Int).SetUint64(ownership.burned),
+		CirculatingSupply: big.NewInt(int64(len(ownership.owners))),
+		UniqueOwners:      len(owners),
+	}
+}
+
+// Applies the Transfer events since the last scan to the ownership map
+func (erc721 *ERC721) syncOwnership(ctx context.Context) error {
+	ownership := erc721.ownership
+	ownership.mu.Lock()
+	defer ownership.mu.Unlock()
+
+	provider := erc721.helper.GetProvider()
+
+	if ownership.nextBlock == 0 {
+		deployment, err := fetchContractDeployment(ctx, erc721.helper.getAddress().String(), provider)
+		if err != nil {
+			return err
+		}
+		ownership.nextBlock = deployment.BlockNumber
+	}
+
+	latest, err := provider.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	// RPCs limit how many blocks a single log query can cover
+	for start := ownership.nextBlock; start <= latest; start += logsBlockRange {
+		end := start + logsBlockRange - 1
+		if end > latest {
+			end = latest
+		}
+
+		iterator, err := erc721.token.FilterTransfer(&bind.FilterOpts{Start: start, End: &end, Context: ctx}, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+
+		for iterator.Next() {
+			tokenId := iterator.Event.TokenId.String()
+			if iterator.Event.From == (common.Address{}) {
+				ownership.minted += 1
+			}
+			if iterator.Event.To == (common.Address{}) {
+				ownership.burned += 1
+				delete(ownership.owners, tokenId)
+			} else {
+				ownership.owners[tokenId] = iterator.Event.To
+			}
+		}
+
+		err = iterator.Error()
+		iterator.Close()
+		if err != nil {
+			return err
+		}
+
+		// Only move forward once a range is fully applied, so a failed call can resume from here
+		ownership.nextBlock = end + 1
+	}
+
+	return nil
+}
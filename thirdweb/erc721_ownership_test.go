@@ -0,0 +1,23 @@
+package thirdweb
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestERC721OwnershipTokensOf(t *testing.T) {
+	owner := common.HexToAddress("0x1")
+	other := common.HexToAddress("0x2")
+
+	ownership := &erc721Ownership{owners: map[string]common.Address{
+		"10": owner,
+		"2":  owner,
+		"3":  other,
+	}}
+
+	assert.Equal(t, []*big.Int{big.NewInt(2), big.NewInt(10)}, ownership.tokensOf(owner))
+	assert.Equal(t, []*big.Int{}, ownership.tokensOf(common.HexToAddress("0x3")))
+}
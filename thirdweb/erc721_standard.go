@@ -5,7 +5,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 )
 
 // This interface is currently support by the NFT Collection and NFT Drop contracts.
@@ -15,8 +14,8 @@ type ERC721Standard struct {
 }
 
 
-func newERC721Standard(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*ERC721Standard, error) {
-	erc721, err := newERC721(provider, address, privateKey, storage)
+func newERC721Standard(handler *ProviderHandler, address common.Address, storage storage) (*ERC721Standard, error) {
+	erc721, err := newERC721(handler, address, storage)
 	if err != nil {
 		return nil, err
 	}
@@ -123,8 +122,8 @@ func (erc721 *ERC721Standard) IsApproved(ctx context.Context, address string, op
 //	tokenId := 0
 //
 //	tx, err := contract.Transfer(context.Background(), to, tokenId)
-func (erc721 *ERC721Standard) Transfer(ctx context.Context, to string, tokenId int) (*types.Transaction, error) {
-	return erc721.erc721.Transfer(ctx, to, tokenId)
+func (erc721 *ERC721Standard) Transfer(ctx context.Context, to string, tokenId int, options ...*TransactionOptions) (*types.Transaction, error) {
+	return erc721.erc721.Transfer(ctx, to, tokenId, options...)
 }
 
 // Burn a specified NFT from the connected wallet.
@@ -137,8 +136,8 @@ func (erc721 *ERC721Standard) Transfer(ctx context.Context, to string, tokenId i
 //
 //	tokenId := 0
 //	tx, err := contract.Burn(context.Background(), tokenId)
-func (erc721 *ERC721Standard) Burn(ctx context.Context, tokenId int) (*types.Transaction, error) {
-	return erc721.erc721.Burn(ctx, tokenId)
+func (erc721 *ERC721Standard) Burn(ctx context.Context, tokenId int, options ...*TransactionOptions) (*types.Transaction, error) {
+	return erc721.erc721.Burn(ctx, tokenId, options...)
 }
 
 // Set the approval for all operations of a specific address's assets.
@@ -150,8 +149,8 @@ func (erc721 *ERC721Standard) Burn(ctx context.Context, tokenId int) (*types.Tra
 // approved: true if the operator is approved for all operations of the assets, otherwise false
 //
 // returns: the transaction receipt of the approval
-func (erc721 *ERC721Standard) SetApprovalForAll(ctx context.Context, operator string, approved bool) (*types.Transaction, error) {
-	return erc721.erc721.SetApprovalForAll(ctx, operator, approved)
+func (erc721 *ERC721Standard) SetApprovalForAll(ctx context.Context, operator string, approved bool, options ...*TransactionOptions) (*types.Transaction, error) {
+	return erc721.erc721.SetApprovalForAll(ctx, operator, approved, options...)
 }
 
 // Approve an operator for the NFT owner, which allows the operator to call transferFrom or
@@ -162,6 +161,6 @@ func (erc721 *ERC721Standard) SetApprovalForAll(ctx context.Context, operator st
 // tokenId: the token ID of the NFT to approve
 //
 // returns: the transaction receipt of the approval
-func (erc721 *ERC721Standard) SetApprovalForToken(ctx context.Context, operator string, tokenId int) (*types.Transaction, error) {
-	return erc721.erc721.SetApprovalForToken(ctx, operator, tokenId)
+func (erc721 *ERC721Standard) SetApprovalForToken(ctx context.Context, operator string, tokenId int, options ...*TransactionOptions) (*types.Transaction, error) {
+	return erc721.erc721.SetApprovalForToken(ctx, operator, tokenId, options...)
 }
\ No newline at end of file
@@ -1,6 +1,64 @@
 package thirdweb
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors for the failure modes callers commonly need to handle, which can be checked for with
+// errors.Is, for example errors.Is(err, thirdweb.ErrNoSigner)
+var (
+	ErrNoSigner            = errors.New("You need to set a private key to use this function!")
+	ErrTokenNotFound       = errors.New("Token not found")
+	ErrInsufficientBalance = errors.New("Insufficient balance")
+	ErrInvalidAddress      = errors.New("Invalid address")
+	// The signature of a signature mint payload doesn't recover to a wallet with the minter role
+	ErrInvalidMintSignature = errors.New("Invalid mint signature")
+	// The contract doesn't implement the function that was called
+	ErrFunctionNotSupported = errors.New("Function not supported by the contract")
+)
+
+// Returned when a transaction is mined but reverts. Reason is the decoded revert reason or custom
+// error, and is empty if it couldn't be recovered.
+type ErrTransactionReverted struct {
+	TxHash string
+	Reason string
+}
+
+func (m *ErrTransactionReverted) Error() string {
+	if m.Reason == "" {
+		return fmt.Sprintf("Transaction %s reverted", m.TxHash)
+	}
+	return fmt.Sprintf("Transaction %s reverted: %s", m.TxHash, m.Reason)
+}
+
+// Returned when the IPFS gateway at URL doesn't respond in time
+type ErrGatewayTimeout struct {
+	URL string
+}
+
+func (m *ErrGatewayTimeout) Error() string {
+	return fmt.Sprintf("Timed out fetching %s from the IPFS gateway", m.URL)
+}
+
+// Returned when the chain ID of a signed payload doesn't match the expected chain
+type ErrChainIDMismatch struct {
+	Expected int
+	Got      int
+}
+
+func (m *ErrChainIDMismatch) Error() string {
+	return fmt.Sprintf("Chain ID '%d' does not match payload chain ID '%d'", m.Expected, m.Got)
+}
+
+// Returned when updating the metadata of an NFT whose metadata was frozen
+type ErrMetadataFrozen struct {
+	TokenId int
+}
+
+func (m *ErrMetadataFrozen) Error() string {
+	return fmt.Sprintf("Metadata of token %d is frozen and can't be updated", m.TokenId)
+}
 
 type notFoundError struct {
 	identifier interface{}
@@ -10,6 +68,10 @@ func (m *notFoundError) Error() string {
 	return fmt.Sprintf("Could not find with id %v", m.identifier)
 }
 
+func (m *notFoundError) Is(target error) bool {
+	return target == ErrTokenNotFound
+}
+
 type unmarshalError struct {
 	body            string
 	typeName        string
@@ -29,6 +91,10 @@ func (m *noSignerError) Error() string {
 	return fmt.Sprintf("Could not proceed with transaction in %v module, missing SigningMethod", m.typeName)
 }
 
+func (m *noSignerError) Is(target error) bool {
+	return target == ErrNoSigner
+}
+
 type noAddressError struct {
 	typeName string
 }
@@ -37,6 +103,10 @@ func (m *noAddressError) Error() string {
 	return fmt.Sprintf("Could not proceed with transaction in %v module, missing or invalid signer address", m.typeName)
 }
 
+func (m *noAddressError) Is(target error) bool {
+	return target == ErrInvalidAddress
+}
+
 type unsupportedFunctionError struct {
 	typeName string
 	body     string
@@ -46,6 +116,10 @@ func (m *unsupportedFunctionError) Error() string {
 	return fmt.Sprintf("The method you're executing in the %v module is not supported yet. %v", m.typeName, m.body)
 }
 
+func (m *unsupportedFunctionError) Is(target error) bool {
+	return target == ErrFunctionNotSupported
+}
+
 type failedToUploadError struct {
 	statusCode      int
 	Payload         interface{}
@@ -55,3 +129,25 @@ type failedToUploadError struct {
 func (m *failedToUploadError) Error() string {
 	return fmt.Sprintf("Failed to upload, status code = %d", m.statusCode)
 }
+
+// Returned when the signer modifies or cancels a marketplace listing it didn't create
+type NotListingOwnerError struct {
+	ListingId int
+	Owner     string
+}
+
+func (m *NotListingOwnerError) Error() string {
+	return fmt.Sprintf("Listing %d can only be modified by its owner %s", m.ListingId, m.Owner)
+}
+
+type claimIneligibleError struct {
+	reason ClaimEligibility
+}
+
+func (m *claimIneligibleError) Error() string {
+	return fmt.Sprintf("Can't claim: %s", m.reason)
+}
+
+func (m *claimIneligibleError) Is(target error) bool {
+	return target == ErrInsufficientBalance && m.reason == InsufficientBalance
+}
@@ -0,0 +1,27 @@
+package thirdweb
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorsMatchSentinels(t *testing.T) {
+	assert.True(t, errors.Is(&notFoundError{1}, ErrTokenNotFound))
+	assert.True(t, errors.Is(&noSignerError{typeName: "test"}, ErrNoSigner))
+	assert.True(t, errors.Is(&noAddressError{typeName: "test"}, ErrInvalidAddress))
+	assert.True(t, errors.Is(&claimIneligibleError{reason: InsufficientBalance}, ErrInsufficientBalance))
+	assert.False(t, errors.Is(&claimIneligibleError{reason: NotEnoughSupply}, ErrInsufficientBalance))
+
+	wrapped := fmt.Errorf("wrapped: %w", &ErrTransactionReverted{TxHash: "0x1", Reason: "Not enough tokens"})
+	var reverted *ErrTransactionReverted
+	assert.True(t, errors.As(wrapped, &reverted))
+	assert.Equal(t, "Not enough tokens", reverted.Reason)
+
+	wrapped = fmt.Errorf("wrapped: %w", &NotListingOwnerError{ListingId: 1, Owner: "0x2"})
+	var notOwner *NotListingOwnerError
+	assert.True(t, errors.As(wrapped, &notOwner))
+	assert.Equal(t, 1, notOwner.ListingId)
+}
@@ -0,0 +1,81 @@
+package thirdweb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const etherscanApiUrl = "https://api.etherscan.io/v2/api"
+
+// Client for the Etherscan API, which serves the ABIs of verified contracts on every chain
+// Etherscan supports through a single endpoint
+type etherscanClient struct {
+	apiKey     string
+	httpClient *http.Client
+}
+
+type etherscanResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Result  string `json:"result"`
+}
+
+func newEtherscanClient(apiKey string, httpClient *http.Client) *etherscanClient {
+	return &etherscanClient{
+		apiKey:     apiKey,
+		httpClient: httpClient,
+	}
+}
+
+func (etherscan *etherscanClient) getContractAbi(ctx context.Context, chainId ChainID, address string, apiKey string) (string, error) {
+	if apiKey == "" {
+		apiKey = etherscan.apiKey
+	}
+	if apiKey == "" {
+		return "", fmt.Errorf("Fetching a verified contract ABI requires an Etherscan API key")
+	}
+
+	query := url.Values{}
+	query.Set("chainid", strconv.Itoa(int(chainId)))
+	query.Set("module", "contract")
+	query.Set("action", "getabi")
+	query.Set("address", address)
+	query.Set("apikey", apiKey)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, etherscanApiUrl+"?"+query.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := etherscan.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Etherscan request failed with status code %d: %s", res.StatusCode, string(body))
+	}
+
+	response := &etherscanResponse{}
+	if err := json.Unmarshal(body, response); err != nil {
+		return "", &unmarshalError{body: string(body), typeName: "etherscanResponse", UnderlyingError: err}
+	}
+
+	// Errors like unverified contracts come back with a 200 and the reason in the result
+	if response.Status != "1" {
+		return "", fmt.Errorf("Failed to fetch ABI for contract '%s' from Etherscan: %s", address, response.Result)
+	}
+
+	return response.Result, nil
+}
@@ -0,0 +1,120 @@
+package thirdweb
+
+import (
+	"reflect"
+	"sync"
+)
+
+// SDK EVENTS
+
+const (
+	EventTransactionSent      = "TransactionSent"
+	EventTransactionConfirmed = "TransactionConfirmed"
+	EventTransactionSimulated = "TransactionSimulated"
+	EventMetadataFetched      = "MetadataFetched"
+	// Emitted when fetching from the IPFS gateway fails and the next fallback gateway is tried
+	EventGatewayFallback = "GatewayFallback"
+)
+
+type Event struct {
+	Type string
+	Data map[string]interface{}
+}
+
+// The event emitter lets you listen for events published by every module of the SDK, which is
+// useful for surfacing progress to your users without wrapping every SDK call. You can access
+// the event emitter from the SDK as follows:
+//
+//	unsubscribe := sdk.On(thirdweb.EventTransactionSent, func(event thirdweb.Event) {
+//		fmt.Printf("%s: %v\n", event.Type, event.Data)
+//	})
+//
+//	// And remove the handler once you don't need it anymore
+//	unsubscribe()
+type EventEmitter struct {
+	mu       sync.RWMutex
+	handlers map[string][]eventHandler
+	nextId   uint64
+}
+
+type eventHandler struct {
+	id      uint64
+	handler func(Event)
+}
+
+func newEventEmitter() *EventEmitter {
+	return &EventEmitter{
+		handlers: map[string][]eventHandler{},
+	}
+}
+
+// Add a handler to be called every time an event of the given type is emitted.
+//
+// eventType: the type of the event to listen for (e.g. thirdweb.EventTransactionSent)
+//
+// handler: the function to call with the emitted event
+//
+// returns: a function that removes this handler, and only this one, when called
+func (emitter *EventEmitter) On(eventType string, handler func(Event)) func() {
+	emitter.mu.Lock()
+	defer emitter.mu.Unlock()
+
+	emitter.nextId += 1
+	id := emitter.nextId
+	emitter.handlers[eventType] = append(emitter.handlers[eventType], eventHandler{id, handler})
+
+	return func() {
+		emitter.remove(eventType, func(h eventHandler) bool {
+			return h.id == id
+		})
+	}
+}
+
+// Remove a handler previously added with On.
+//
+// Functions can't be compared in Go, so handlers are matched by their code pointer, and every
+// closure created from the same function literal is removed at once. Use the function returned
+// by On to remove a single handler.
+//
+// eventType: the type of the event the handler was added for
+//
+// handler: the function that was passed to On
+func (emitter *EventEmitter) Off(eventType string, handler func(Event)) {
+	pointer := reflect.ValueOf(handler).Pointer()
+	emitter.remove(eventType, func(h eventHandler) bool {
+		return reflect.ValueOf(h.handler).Pointer() == pointer
+	})
+}
+
+func (emitter *EventEmitter) remove(eventType string, matches func(eventHandler) bool) {
+	emitter.mu.Lock()
+	defer emitter.mu.Unlock()
+
+	handlers := []eventHandler{}
+	for _, h := range emitter.handlers[eventType] {
+		if !matches(h) {
+			handlers = append(handlers, h)
+		}
+	}
+
+	emitter.handlers[eventType] = handlers
+}
+
+func (emitter *EventEmitter) emit(eventType string, data map[string]interface{}) {
+	if emitter == nil {
+		return
+	}
+
+	emitter.mu.RLock()
+	handlers := make([]eventHandler, len(emitter.handlers[eventType]))
+	copy(handlers, emitter.handlers[eventType])
+	emitter.mu.RUnlock()
+
+	event := Event{
+		Type: eventType,
+		Data: data,
+	}
+	for _, h := range handlers {
+		h.handler(event)
+	}
+}
@@ -0,0 +1,49 @@
+package thirdweb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventEmitter(t *testing.T) {
+	emitter := newEventEmitter()
+
+	events := []Event{}
+	handler := func(event Event) {
+		events = append(events, event)
+	}
+
+	emitter.On(EventTransactionSent, handler)
+	emitter.emit(EventTransactionSent, map[string]interface{}{"hash": "0x1"})
+	emitter.emit(EventTransactionConfirmed, map[string]interface{}{"hash": "0x1"})
+
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, EventTransactionSent, events[0].Type)
+	assert.Equal(t, "0x1", events[0].Data["hash"])
+
+	emitter.Off(EventTransactionSent, handler)
+	emitter.emit(EventTransactionSent, map[string]interface{}{"hash": "0x2"})
+
+	assert.Equal(t, 1, len(events))
+}
+
+func TestEventEmitterUnsubscribe(t *testing.T) {
+	emitter := newEventEmitter()
+
+	// Both handlers come from the same function literal, so they share a code pointer
+	counts := make([]int, 2)
+	unsubscribes := []func(){}
+	for i := range counts {
+		i := i
+		unsubscribes = append(unsubscribes, emitter.On(EventTransactionSent, func(event Event) {
+			counts[i] += 1
+		}))
+	}
+
+	emitter.emit(EventTransactionSent, nil)
+	unsubscribes[0]()
+	emitter.emit(EventTransactionSent, nil)
+
+	assert.Equal(t, []int{1, 2}, counts)
+}
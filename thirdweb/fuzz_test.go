@@ -0,0 +1,40 @@
+//go:build go1.18
+// +build go1.18
+
+package thirdweb
+
+import (
+	"testing"
+)
+
+func FuzzExtractMinimalProxyImplementationAddress(f *testing.F) {
+	f.Add([]byte{0x36, 0x3d, 0x3d, 0x37, 0x3d, 0x3d, 0x3d, 0x36, 0x3d, 0x73})
+	f.Add([]byte{0x36, 0x60, 0x30, 0x57, 0x34, 0x3d, 0x52, 0x30})
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, bytecode []byte) {
+		address := extractMinimalProxyImplementationAddress(bytecode)
+		if address != "" && len(address) != 42 {
+			t.Errorf("Extracted invalid implementation address %s", address)
+		}
+	})
+}
+
+func FuzzExtractIPFSHashFromBytecode(f *testing.F) {
+	f.Add([]byte{0x00, 0x33})
+	f.Add([]byte{0xa1, 0x64, 0x69, 0x70, 0x66, 0x73, 0x40, 0x00, 0x07})
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, bytecode []byte) {
+		extractIPFSHashFromBytecode(bytecode)
+	})
+}
+
+func FuzzDecodeCustomError(f *testing.F) {
+	f.Add(append(revertReasonSelector, make([]byte, 64)...))
+	f.Add([]byte{0x01, 0x02, 0x03, 0x04})
+
+	f.Fuzz(func(t *testing.T, revertData []byte) {
+		DecodeCustomError(customErrorAbi, revertData)
+	})
+}
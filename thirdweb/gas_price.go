@@ -0,0 +1,143 @@
+package thirdweb
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+type GasTier string
+
+const (
+	GasTierSlow     GasTier = "slow"
+	GasTierStandard GasTier = "standard"
+	GasTierFast     GasTier = "fast"
+)
+
+// Number of recent blocks the priority fees are sampled from
+const gasFeeHistoryBlocks = 20
+
+// Percentile of the priority fees paid in recent blocks that each tier bids
+var gasTierRewardPercentiles = []float64{10, 50, 90}
+
+type gasTierSettings struct {
+	// Index into gasTierRewardPercentiles
+	rewardIndex int
+	// Percentage applied to eth_gasPrice on chains without EIP-1559
+	legacyMultiplier int64
+	// Number of blocks a transaction at this tier is expected to wait for
+	blocksToWait int64
+}
+
+func getGasTierSettings(tier GasTier) (*gasTierSettings, error) {
+	switch tier {
+	case GasTierSlow:
+		return &gasTierSettings{rewardIndex: 0, legacyMultiplier: 90, blocksToWait: 6}, nil
+	case GasTierStandard:
+		return &gasTierSettings{rewardIndex: 1, legacyMultiplier: 100, blocksToWait: 3}, nil
+	case GasTierFast:
+		return &gasTierSettings{rewardIndex: 2, legacyMultiplier: 125, blocksToWait: 1}, nil
+	default:
+		return nil, fmt.Errorf("Unknown gas tier '%s'", tier)
+	}
+}
+
+// GetGasPrice
+//
+// # Estimate the gas fees for a transaction to be included at a given speed
+//
+// On EIP-1559 chains the priority fee is a percentile of the priority fees paid in the last 20
+// blocks, from eth_feeHistory, and the max fee leaves room for the base fee to double. On other
+// chains, and for SDKs created with NewThirdwebSDKFromProvider, both fees are set to eth_gasPrice
+// scaled for the tier.
+//
+// tier: GasTierSlow, GasTierStandard or GasTierFast
+//
+// returns: the fees to pay and the estimated wait until the transaction is included
+//
+// Example
+//
+//	estimate, err := sdk.GetGasPrice(context.Background(), thirdweb.GasTierFast)
+//	tx, err := contract.ERC20.Transfer(context.Background(), to, 1, &thirdweb.TransactionOptions{
+//		MaxFeePerGas:         estimate.MaxFeePerGas,
+//		MaxPriorityFeePerGas: estimate.MaxPriorityFeePerGas,
+//	})
+func (sdk *ThirdwebSDK) GetGasPrice(ctx context.Context, tier GasTier) (*GasPriceEstimate, error) {
+	settings, err := getGasTierSettings(tier)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdk.GetProvider()
+	blockTime, err := getAverageBlockTime(ctx, provider)
+	if err != nil {
+		return nil, err
+	}
+	estimatedWait := blockTime * time.Duration(settings.blocksToWait)
+
+	// Chains without EIP-1559 either don't implement eth_feeHistory or report no base fee. SDKs
+	// created from a provider can't call it either, and get the legacy estimate.
+	feeHistory, err := sdk.getFeeHistory(ctx, gasFeeHistoryBlocks, gasTierRewardPercentiles)
+	if err != nil || len(feeHistory.BaseFee) == 0 || feeHistory.BaseFee[len(feeHistory.BaseFee)-1].Sign() == 0 {
+		gasPrice, err := provider.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		gasPrice = new(big.Int).Div(new(big.Int).Mul(gasPrice, big.NewInt(settings.legacyMultiplier)), big.NewInt(100))
+		return &GasPriceEstimate{
+			MaxFeePerGas:         gasPrice,
+			MaxPriorityFeePerGas: gasPrice,
+			EstimatedWait:        estimatedWait,
+		}, nil
+	}
+
+	// The last base fee is the one of the next block
+	baseFee := feeHistory.BaseFee[len(feeHistory.BaseFee)-1]
+	priorityFee := averageReward(feeHistory.Reward, settings.rewardIndex)
+
+	return &GasPriceEstimate{
+		MaxFeePerGas:         new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), priorityFee),
+		MaxPriorityFeePerGas: priorityFee,
+		EstimatedWait:        estimatedWait,
+	}, nil
+}
+
+// Averages the reward at the given percentile index over the sampled blocks, skipping empty blocks
+// which report a reward of zero
+func averageReward(rewards [][]*big.Int, index int) *big.Int {
+	total := big.NewInt(0)
+	count := int64(0)
+	for _, blockRewards := range rewards {
+		if index < len(blockRewards) && blockRewards[index] != nil && blockRewards[index].Sign() > 0 {
+			total.Add(total, blockRewards[index])
+			count += 1
+		}
+	}
+
+	if count == 0 {
+		return total
+	}
+	return total.Div(total, big.NewInt(count))
+}
+
+func getAverageBlockTime(ctx context.Context, provider *ethclient.Client) (time.Duration, error) {
+	latest, err := provider.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if latest.Number.Int64() < gasFeeHistoryBlocks {
+		return 0, nil
+	}
+
+	earlier, err := provider.HeaderByNumber(ctx, new(big.Int).Sub(latest.Number, big.NewInt(gasFeeHistoryBlocks)))
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(latest.Time-earlier.Time) * time.Second / gasFeeHistoryBlocks, nil
+}
@@ -0,0 +1,134 @@
+package thirdweb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAverageReward(t *testing.T) {
+	rewards := [][]*big.Int{
+		{big.NewInt(1), big.NewInt(10), big.NewInt(100)},
+		// Empty blocks report no priority fee
+		{big.NewInt(0), big.NewInt(0), big.NewInt(0)},
+		{big.NewInt(3), big.NewInt(20), big.NewInt(300)},
+	}
+
+	assert.Equal(t, big.NewInt(2), averageReward(rewards, 0))
+	assert.Equal(t, big.NewInt(15), averageReward(rewards, 1))
+	assert.Equal(t, big.NewInt(200), averageReward(rewards, 2))
+	assert.Equal(t, big.NewInt(0), averageReward([][]*big.Int{}, 1))
+}
+
+func TestGetGasTierSettings(t *testing.T) {
+	fast, err := getGasTierSettings(GasTierFast)
+	assert.Nil(t, err)
+	slow, err := getGasTierSettings(GasTierSlow)
+	assert.Nil(t, err)
+	assert.Greater(t, fast.legacyMultiplier, slow.legacyMultiplier)
+	assert.Less(t, fast.blocksToWait, slow.blocksToWait)
+
+	_, err = getGasTierSettings("instant")
+	assert.NotNil(t, err)
+}
+
+// Answers the JSON-RPC methods used by GetGasPrice, with a block every 2 seconds
+func newGasPriceRpcServer(t *testing.T, supportsFeeHistory bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Id     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var result interface{}
+		switch req.Method {
+		case "eth_chainId":
+			result = "0x1"
+		case "eth_gasPrice":
+			result = "0x64"
+		case "eth_getBlockByNumber":
+			number := uint64(100)
+			if string(req.Params[0]) != `"latest"` {
+				var tag string
+				assert.Nil(t, json.Unmarshal(req.Params[0], &tag))
+				number = hexutil.MustDecodeUint64(tag)
+			}
+			result = map[string]interface{}{
+				"parentHash":       common.Hash{},
+				"sha3Uncles":       common.Hash{},
+				"miner":            common.Address{},
+				"stateRoot":        common.Hash{},
+				"transactionsRoot": common.Hash{},
+				"receiptsRoot":     common.Hash{},
+				"logsBloom":        types.Bloom{},
+				"difficulty":       "0x0",
+				"number":           hexutil.Uint64(number),
+				"gasLimit":         "0x0",
+				"gasUsed":          "0x0",
+				"timestamp":        hexutil.Uint64(number * 2),
+				"extraData":        "0x",
+				"transactions":     []interface{}{},
+				"uncles":           []interface{}{},
+			}
+		case "eth_feeHistory":
+			if !supportsFeeHistory {
+				w.Write([]byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"error":{"code":-32601,"message":"method not found"}}`, req.Id)))
+				return
+			}
+			result = map[string]interface{}{
+				"oldestBlock":   "0x51",
+				"baseFeePerGas": []string{"0x3e8", "0x7d0"},
+				"gasUsedRatio":  []float64{0.5},
+				"reward":        [][]string{{"0xa", "0x14", "0x1e"}},
+			}
+		default:
+			t.Fatalf("Unexpected RPC method %s", req.Method)
+		}
+
+		encoded, err := json.Marshal(result)
+		assert.Nil(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":%s}`, req.Id, encoded)))
+	}))
+}
+
+func TestGetGasPriceWithFeeHistory(t *testing.T) {
+	server := newGasPriceRpcServer(t, true)
+	defer server.Close()
+
+	sdk, err := NewThirdwebSDK(server.URL, nil)
+	assert.Nil(t, err)
+
+	estimate, err := sdk.GetGasPrice(context.Background(), GasTierFast)
+	assert.Nil(t, err)
+	// Twice the next base fee plus the 90th percentile priority fee
+	assert.Equal(t, big.NewInt(30), estimate.MaxPriorityFeePerGas)
+	assert.Equal(t, big.NewInt(4030), estimate.MaxFeePerGas)
+	assert.Equal(t, 2*time.Second, estimate.EstimatedWait)
+}
+
+func TestGetGasPriceFallsBackToLegacy(t *testing.T) {
+	server := newGasPriceRpcServer(t, false)
+	defer server.Close()
+
+	sdk, err := NewThirdwebSDK(server.URL, nil)
+	assert.Nil(t, err)
+
+	estimate, err := sdk.GetGasPrice(context.Background(), GasTierSlow)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(90), estimate.MaxPriorityFeePerGas)
+	assert.Equal(t, big.NewInt(90), estimate.MaxFeePerGas)
+	assert.Equal(t, 12*time.Second, estimate.EstimatedWait)
+}
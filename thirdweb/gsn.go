@@ -0,0 +1,361 @@
+package thirdweb
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	ethAbi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	signerTypes "github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const gsnForwarderAbi = `[{
+	"type": "function",
+	"name": "getNonce",
+	"stateMutability": "view",
+	"inputs": [{"name": "from", "type": "address"}],
+	"outputs": [{"name": "", "type": "uint256"}]
+}]`
+
+const (
+	// Number of blocks a relay request stays valid for
+	gsnValidUntilBlocks = 600
+	// How far ahead of the current nonce of the relay worker we accept the relayed transaction
+	gsnMaxRelayNonceGap = 3
+)
+
+// Sends transactions through an OpenGSN relay. The call is wrapped in an ERC-2771 forward request
+// signed by the SDK wallet, which the relay submits to the relay hub and the paymaster pays for.
+type gsnBroadcaster struct {
+	handler    *ProviderHandler
+	options    *OpenGSNOptions
+	httpClient *http.Client
+}
+
+type gsnRelayInfo struct {
+	RelayWorkerAddress string `json:"relayWorkerAddress"`
+	RelayHubAddress    string `json:"relayHubAddress"`
+	MinGasPrice        string `json:"minGasPrice"`
+	Ready              bool   `json:"ready"`
+}
+
+type gsnForwardRequest struct {
+	From       string `json:"from"`
+	To         string `json:"to"`
+	Value      string `json:"value"`
+	Gas        string `json:"gas"`
+	Nonce      string `json:"nonce"`
+	Data       string `json:"data"`
+	ValidUntil string `json:"validUntil"`
+}
+
+type gsnRelayData struct {
+	GasPrice      string `json:"gasPrice"`
+	PctRelayFee   string `json:"pctRelayFee"`
+	BaseRelayFee  string `json:"baseRelayFee"`
+	RelayWorker   string `json:"relayWorker"`
+	Paymaster     string `json:"paymaster"`
+	Forwarder     string `json:"forwarder"`
+	PaymasterData string `json:"paymasterData"`
+	ClientId      string `json:"clientId"`
+}
+
+type gsnRelayResponse struct {
+	SignedTx string `json:"signedTx"`
+	Error    string `json:"error"`
+}
+
+func newGSNBroadcaster(handler *ProviderHandler, options *OpenGSNOptions, httpClient *http.Client) *gsnBroadcaster {
+	return &gsnBroadcaster{
+		handler:    handler,
+		options:    options,
+		httpClient: httpClient,
+	}
+}
+
+func (broadcaster *gsnBroadcaster) sponsorsGas() {}
+
+func (broadcaster *gsnBroadcaster) Send(ctx context.Context, tx *types.Transaction) (common.Hash, error) {
+	if tx.To() == nil {
+		return common.Hash{}, fmt.Errorf("OpenGSN can't relay contract deployments")
+	}
+
+	privateKey := broadcaster.handler.GetPrivateKey()
+	if privateKey == nil {
+		return common.Hash{}, &noSignerError{typeName: "gsn"}
+	}
+
+	provider := broadcaster.handler.GetProvider()
+	chainId, err := broadcaster.handler.GetChainID(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	relay, err := broadcaster.getRelayInfo(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if !relay.Ready {
+		return common.Hash{}, fmt.Errorf("OpenGSN relay %s isn't ready", broadcaster.options.PreferredRelayUrl)
+	}
+	if !strings.EqualFold(relay.RelayHubAddress, broadcaster.options.RelayHubAddress) {
+		return common.Hash{}, fmt.Errorf("OpenGSN relay uses relay hub %s instead of %s", relay.RelayHubAddress, broadcaster.options.RelayHubAddress)
+	}
+
+	gasPrice, err := provider.SuggestGasPrice(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if minGasPrice, ok := new(big.Int).SetString(relay.MinGasPrice, 10); ok && minGasPrice.Cmp(gasPrice) > 0 {
+		gasPrice = minGasPrice
+	}
+
+	from := broadcaster.handler.GetSignerAddress()
+	forwarder := common.HexToAddress(broadcaster.options.ForwarderAddress)
+	nonce, err := broadcaster.getNonce(ctx, forwarder, from)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	blockNumber, err := provider.BlockNumber(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	workerNonce, err := provider.PendingNonceAt(ctx, common.HexToAddress(relay.RelayWorkerAddress))
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	request := &gsnForwardRequest{
+		From:       from.Hex(),
+		To:         tx.To().Hex(),
+		Value:      tx.Value().String(),
+		Gas:        fmt.Sprintf("%v", tx.Gas()),
+		Nonce:      nonce.String(),
+		Data:       hexutil.Encode(tx.Data()),
+		ValidUntil: fmt.Sprintf("%v", blockNumber+gsnValidUntilBlocks),
+	}
+
+	relayData := &gsnRelayData{
+		GasPrice:      gasPrice.String(),
+		PctRelayFee:   fmt.Sprintf("%v", broadcaster.options.PctRelayFee),
+		BaseRelayFee:  fmt.Sprintf("%v", broadcaster.options.BaseRelayFee),
+		RelayWorker:   relay.RelayWorkerAddress,
+		Paymaster:     broadcaster.options.PaymasterAddress,
+		Forwarder:     forwarder.Hex(),
+		PaymasterData: "0x",
+		ClientId:      "1",
+	}
+
+	typedData := generateGSNMessage(chainId, request, relayData, tx.Data())
+
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
+	sigHash := crypto.Keccak256(rawData)
+
+	signatureHash, err := crypto.Sign(sigHash, privateKey)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	// We need this to correct v = 0,1 to v = 27,28 - or else all will break
+	if signatureHash[64] == 0 || signatureHash[64] == 1 {
+		signatureHash[64] += 27
+	}
+
+	return broadcaster.relay(ctx, map[string]interface{}{
+		"relayRequest": map[string]interface{}{
+			"request":   request,
+			"relayData": relayData,
+		},
+		"metadata": map[string]interface{}{
+			"signature":       "0x" + hex.EncodeToString(signatureHash),
+			"approvalData":    "0x",
+			"relayHubAddress": broadcaster.options.RelayHubAddress,
+			"relayMaxNonce":   workerNonce + gsnMaxRelayNonceGap,
+		},
+	})
+}
+
+func (broadcaster *gsnBroadcaster) getRelayInfo(ctx context.Context) (*gsnRelayInfo, error) {
+	relayUrl := strings.TrimSuffix(broadcaster.options.PreferredRelayUrl, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, relayUrl+"/getaddr", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := broadcaster.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &gsnRelayInfo{}
+	if err := json.Unmarshal(body, info); err != nil {
+		return nil, &unmarshalError{body: string(body), typeName: "gsnRelayInfo", UnderlyingError: err}
+	}
+
+	return info, nil
+}
+
+func (broadcaster *gsnBroadcaster) getNonce(ctx context.Context, forwarder common.Address, from common.Address) (*big.Int, error) {
+	forwarderAbi, err := ethAbi.JSON(strings.NewReader(gsnForwarderAbi))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := forwarderAbi.Pack("getNonce", from)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := broadcaster.handler.GetProvider().CallContract(ctx, ethereum.CallMsg{To: &forwarder, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := forwarderAbi.Unpack("getNonce", result)
+	if err != nil {
+		return nil, err
+	}
+
+	return values[0].(*big.Int), nil
+}
+
+func (broadcaster *gsnBroadcaster) relay(ctx context.Context, payload interface{}) (common.Hash, error) {
+	reqBody, err := json.Marshal(payload)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	relayUrl := strings.TrimSuffix(broadcaster.options.PreferredRelayUrl, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, relayUrl+"/relay", bytes.NewReader(reqBody))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := broadcaster.do(req)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	response := &gsnRelayResponse{}
+	if err := json.Unmarshal(body, response); err != nil {
+		return common.Hash{}, &unmarshalError{body: string(body), typeName: "gsnRelayResponse", UnderlyingError: err}
+	}
+	if response.Error != "" {
+		return common.Hash{}, fmt.Errorf("OpenGSN relay rejected the transaction: %s", response.Error)
+	}
+
+	rawTx, err := hexutil.Decode(response.SignedTx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	relayedTx := &types.Transaction{}
+	if err := relayedTx.UnmarshalBinary(rawTx); err != nil {
+		return common.Hash{}, err
+	}
+
+	return relayedTx.Hash(), nil
+}
+
+func (broadcaster *gsnBroadcaster) do(req *http.Request) ([]byte, error) {
+	res, err := broadcaster.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OpenGSN relay request failed with status code %d: %s", res.StatusCode, string(body))
+	}
+
+	return body, nil
+}
+
+func generateGSNMessage(chainId *big.Int, request *gsnForwardRequest, relayData *gsnRelayData, data []byte) *signerTypes.TypedData {
+	return &signerTypes.TypedData{
+		Types: signerTypes.Types{
+			"RelayRequest": []signerTypes.Type{
+				{Name: "from", Type: "address"},
+				{Name: "to", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "gas", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "data", Type: "bytes"},
+				{Name: "validUntil", Type: "uint256"},
+				{Name: "relayData", Type: "RelayData"},
+			},
+			"RelayData": []signerTypes.Type{
+				{Name: "gasPrice", Type: "uint256"},
+				{Name: "pctRelayFee", Type: "uint256"},
+				{Name: "baseRelayFee", Type: "uint256"},
+				{Name: "relayWorker", Type: "address"},
+				{Name: "paymaster", Type: "address"},
+				{Name: "forwarder", Type: "address"},
+				{Name: "paymasterData", Type: "bytes"},
+				{Name: "clientId", Type: "uint256"},
+			},
+			"EIP712Domain": []signerTypes.Type{
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+		},
+		PrimaryType: "RelayRequest",
+		Domain: signerTypes.TypedDataDomain{
+			Name:              "GSN Relayed Transaction",
+			Version:           "2",
+			ChainId:           math.NewHexOrDecimal256(chainId.Int64()),
+			VerifyingContract: relayData.Forwarder,
+		},
+		Message: signerTypes.TypedDataMessage{
+			"from":       request.From,
+			"to":         request.To,
+			"value":      request.Value,
+			"gas":        request.Gas,
+			"nonce":      request.Nonce,
+			"data":       data,
+			"validUntil": request.ValidUntil,
+			"relayData": map[string]interface{}{
+				"gasPrice":      relayData.GasPrice,
+				"pctRelayFee":   relayData.PctRelayFee,
+				"baseRelayFee":  relayData.BaseRelayFee,
+				"relayWorker":   relayData.RelayWorker,
+				"paymaster":     relayData.Paymaster,
+				"forwarder":     relayData.Forwarder,
+				"paymasterData": []byte{},
+				"clientId":      relayData.ClientId,
+			},
+		},
+	}
+}
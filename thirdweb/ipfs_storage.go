@@ -10,9 +10,11 @@ import (
 	"io/ioutil"
 	"math/big"
 	"mime/multipart"
+	"net"
 	"net/http"
 	"reflect"
 	"strings"
+	"time"
 )
 
 type baseUriWithUris struct {
@@ -23,6 +25,7 @@ type storage interface {
 	Get(ctx context.Context, uri string) ([]byte, error)
 	Upload(ctx context.Context, data map[string]interface{}, contractAddress string, signerAddress string) (string, error)
 	UploadBatch(ctx context.Context, data []map[string]interface{}, fileStartNumber int, contractAddress string, signerAddress string) (*baseUriWithUris, error)
+	UploadWithProgress(ctx context.Context, data io.Reader, size int64, progressFn func(uploaded, total int64)) (string, error)
 }
 
 type uploadResponse struct {
@@ -35,7 +38,11 @@ type uploadResponse struct {
 
 type IpfsStorage struct {
 	gatewayUrl string
-	httpClient *http.Client
+	// Tried in order when fetching from gatewayUrl fails
+	fallbackGatewayUrls []string
+	httpClient          *http.Client
+	events              *EventEmitter
+	metrics             Metrics
 }
 
 func newIpfsStorage(gatewayUrl string, httpClient *http.Client) *IpfsStorage {
@@ -52,26 +59,61 @@ func newIpfsStorage(gatewayUrl string, httpClient *http.Client) *IpfsStorage {
 // uri: the IPFS URI to fetch data from
 //
 // returns: byte data of the IPFS data at the URI
-func (ipfs *IpfsStorage) Get(ctx context.Context, uri string) ([]byte, error) {
-	gatewayUrl := replaceHashWithGatewayUrl(uri, ipfs.gatewayUrl)
+func (ipfs *IpfsStorage) Get(ctx context.Context, uri string) (body []byte, err error) {
+	start := time.Now()
+	defer func() {
+		if ipfs.metrics != nil {
+			ipfs.metrics.ObserveStorageFetch(time.Since(start), err)
+		}
+	}()
+
+	body, err = ipfs.fetch(ctx, uri, ipfs.gatewayUrl)
+	for _, fallbackGatewayUrl := range ipfs.fallbackGatewayUrls {
+		if err == nil {
+			break
+		}
+
+		ipfs.events.emit(EventGatewayFallback, map[string]interface{}{
+			"uri":     uri,
+			"gateway": fallbackGatewayUrl,
+			"error":   err,
+		})
+		body, err = ipfs.fetch(ctx, uri, fallbackGatewayUrl)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ipfs.events.emit(EventMetadataFetched, map[string]interface{}{
+		"uri": uri,
+	})
+
+	return body, nil
+}
+
+func (ipfs *IpfsStorage) fetch(ctx context.Context, uri string, gateway string) ([]byte, error) {
+	gatewayUrl := replaceHashWithGatewayUrl(uri, gateway)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, gatewayUrl, nil)
 	if err != nil {
 		return nil, err
 	}
 	resp, err := ipfs.httpClient.Do(req)
 	if err != nil {
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			return nil, &ErrGatewayTimeout{URL: gatewayUrl}
+		}
 		return nil, err
 	}
+	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusGatewayTimeout || resp.StatusCode == http.StatusRequestTimeout {
+		return nil, &ErrGatewayTimeout{URL: gatewayUrl}
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, errors.New(fmt.Sprintf("Bad status code, %d", resp.StatusCode))
 	}
 
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return body, nil
+	return ioutil.ReadAll(resp.Body)
 }
 
 // Upload
@@ -136,6 +178,115 @@ func (ipfs *IpfsStorage) UploadBatch(ctx context.Context, data []map[string]inte
 	return baseUriWithUris, nil
 }
 
+// UploadWithProgress
+//
+// Upload a single file to IPFS, streaming it from the reader and reporting progress as bytes
+// are sent. Useful for large assets like videos or 3D models.
+//
+// data: the file contents to upload
+//
+// size: the total size of the file in bytes, passed through to progressFn as the total
+//
+// progressFn: optional callback invoked with the number of bytes uploaded so far
+//
+// returns: the URI of the IPFS upload
+//
+// Example
+//
+//	file, _ := os.Open("video.mp4")
+//	info, _ := file.Stat()
+//
+//	uri, err := storage.UploadWithProgress(context.Background(), file, info.Size(), func(uploaded, total int64) {
+//		fmt.Printf("%d / %d bytes\n", uploaded, total)
+//	})
+func (ipfs *IpfsStorage) UploadWithProgress(ctx context.Context, data io.Reader, size int64, progressFn func(uploaded, total int64)) (string, error) {
+	uploadToken, err := ipfs.getUploadToken(ctx, "")
+	if err != nil {
+		return "", err
+	}
+
+	pipeReader, pipeWriter := io.Pipe()
+	writer := multipart.NewWriter(pipeWriter)
+
+	// Stream the multipart body so progress reflects bytes actually handed to the HTTP client
+	go func() {
+		part, err := writer.CreateFormFile("file", "files/0")
+		if err != nil {
+			pipeWriter.CloseWithError(err)
+			return
+		}
+
+		reader := &progressReader{reader: data, total: size, progressFn: progressFn}
+		if _, err := io.Copy(part, reader); err != nil {
+			pipeWriter.CloseWithError(err)
+			return
+		}
+
+		pipeWriter.CloseWithError(writer.Close())
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, "POST", pinataIpfsUrl, pipeReader)
+	if err != nil {
+		pipeReader.Close()
+		return "", err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %v", uploadToken))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	result, err := ipfs.httpClient.Do(req)
+	if err != nil {
+		pipeReader.Close()
+		return "", err
+	}
+	defer result.Body.Close()
+
+	if result.StatusCode != http.StatusOK {
+		return "", &failedToUploadError{
+			statusCode: result.StatusCode,
+		}
+	}
+
+	bodyBytes, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return "", &failedToUploadError{
+			statusCode:      result.StatusCode,
+			UnderlyingError: err,
+		}
+	}
+
+	var uploadMeta uploadResponse
+	if err := json.Unmarshal(bodyBytes, &uploadMeta); err != nil {
+		return "", &unmarshalError{
+			body:            string(bodyBytes),
+			typeName:        "UploadResponse",
+			UnderlyingError: err,
+		}
+	}
+
+	return "ipfs://" + uploadMeta.IpfsHash + "/0", nil
+}
+
+// progressReader reports the cumulative number of bytes read to progressFn
+type progressReader struct {
+	reader     io.Reader
+	uploaded   int64
+	total      int64
+	progressFn func(uploaded, total int64)
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.uploaded += int64(n)
+		if r.progressFn != nil {
+			r.progressFn(r.uploaded, r.total)
+		}
+	}
+
+	return n, err
+}
+
 func (ipfs *IpfsStorage) getUploadToken(ctx context.Context, contractAddress string) (string, error) {
 	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%v/grant", twIpfsServerUrl), nil)
 	if err != nil {
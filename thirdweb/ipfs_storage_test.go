@@ -0,0 +1,68 @@
+package thirdweb
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgressReaderReportsCumulativeBytes(t *testing.T) {
+	data := bytes.Repeat([]byte("a"), 10)
+
+	reported := []int64{}
+	reader := &progressReader{
+		reader: bytes.NewReader(data),
+		total:  int64(len(data)),
+		progressFn: func(uploaded, total int64) {
+			assert.Equal(t, int64(10), total)
+			reported = append(reported, uploaded)
+		},
+	}
+
+	buf := make([]byte, 4)
+	for {
+		if _, err := reader.Read(buf); err != nil {
+			break
+		}
+	}
+
+	assert.Equal(t, []int64{4, 8, 10}, reported)
+
+	// A nil callback should just pass data through
+	out, err := ioutil.ReadAll(&progressReader{reader: bytes.NewReader(data), total: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, data, out)
+}
+
+func TestGetFallsBackToNextGateway(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/QmHash/0", r.URL.Path)
+		w.Write([]byte(`{"name": "NFT"}`))
+	}))
+	defer healthy.Close()
+
+	storage := newIpfsStorage(failing.URL, http.DefaultClient)
+	storage.fallbackGatewayUrls = []string{healthy.URL}
+	storage.events = newEventEmitter()
+
+	fallbacks := []Event{}
+	storage.events.On(EventGatewayFallback, func(event Event) {
+		fallbacks = append(fallbacks, event)
+	})
+
+	body, err := storage.Get(context.Background(), "ipfs://QmHash/0")
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name": "NFT"}`, string(body))
+	assert.Equal(t, 1, len(fallbacks))
+	assert.Equal(t, healthy.URL, fallbacks[0].Data["gateway"])
+}
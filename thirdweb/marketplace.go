@@ -6,11 +6,11 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 
 	"github.com/thirdweb-dev/go-sdk/v2/abi"
 )
@@ -36,10 +36,12 @@ type Marketplace struct {
 	Events  *ContractEvents
 }
 
-func newMarketplace(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*Marketplace, error) {
-	if contractAbi, err := abi.NewMarketplace(address, provider); err != nil {
+func newMarketplace(handler *ProviderHandler, address common.Address, storage storage) (*Marketplace, error) {
+	backend := handler.getBackend()
+
+	if contractAbi, err := abi.NewMarketplace(address, backend); err != nil {
 		return nil, err
-	} else if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+	} else if helper, err := newContractHelper(address, handler); err != nil {
 		return nil, err
 	} else {
 		encoder, err := newMarketplaceEncoder(contractAbi, helper, storage)
@@ -115,9 +117,12 @@ func (marketplace *Marketplace) GetActiveListings(ctx context.Context, filter *M
 		return nil, err
 	}
 
+	now := int(time.Now().Unix())
 	activeListings := []*DirectListing{}
 	for _, listing := range listings {
-		if listing.Quantity > 0 {
+		// Cancelled listings are already skipped when fetching, so we only need to check that the
+		// listing isn't sold out and that we're within its sale window
+		if listing.Quantity > 0 && listing.StartTimeInEpochSeconds <= now && now < listing.EndTimeInEpochSeconds {
 			activeListings = append(activeListings, listing)
 		}
 	}
@@ -125,6 +130,64 @@ func (marketplace *Marketplace) GetActiveListings(ctx context.Context, filter *M
 	return activeListings, nil
 }
 
+// Get all the listings created by a specific seller.
+//
+// seller: wallet address of the seller
+//
+// returns: all listings in the marketplace created by the seller
+//
+// Example
+//
+//	listings, err := marketplace.GetListingsByOwner(context.Background(), "{{wallet_address}}")
+func (marketplace *Marketplace) GetListingsByOwner(ctx context.Context, seller string) ([]*DirectListing, error) {
+	if !marketplace.Helper.isValidAddress(seller) {
+		return nil, ErrInvalidAddress
+	}
+
+	listings, err := marketplace.getAllListingsNoFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerListings := []*DirectListing{}
+	for _, listing := range listings {
+		if strings.ToLower(listing.SellerAddress) == strings.ToLower(seller) {
+			ownerListings = append(ownerListings, listing)
+		}
+	}
+
+	return ownerListings, nil
+}
+
+// Get all the listings of assets from a specific contract.
+//
+// contractAddress: address of the NFT contract of the listed assets
+//
+// returns: all listings in the marketplace for assets of the contract
+//
+// Example
+//
+//	listings, err := marketplace.GetListingsByTokenAddress(context.Background(), "{{contract_address}}")
+func (marketplace *Marketplace) GetListingsByTokenAddress(ctx context.Context, contractAddress string) ([]*DirectListing, error) {
+	if !marketplace.Helper.isValidAddress(contractAddress) {
+		return nil, ErrInvalidAddress
+	}
+
+	listings, err := marketplace.getAllListingsNoFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenListings := []*DirectListing{}
+	for _, listing := range listings {
+		if strings.ToLower(listing.AssetContractAddress) == strings.ToLower(contractAddress) {
+			tokenListings = append(tokenListings, listing)
+		}
+	}
+
+	return tokenListings, nil
+}
+
 // Get all the listings from the marketplace.
 //
 // filter: optional filter parameters
@@ -159,8 +222,214 @@ func (marketplace *Marketplace) GetTotalCount(ctx context.Context) (int, error)
 	return int(total.Int64()), nil
 }
 
+// Get the minimum increment required for each new bid on an auction, in basis points.
+//
+// returns: the bid buffer in basis points (e.g. 500 is 5%)
+func (marketplace *Marketplace) GetBidBufferBps(ctx context.Context) (int, error) {
+	bidBufferBps, err := marketplace.Abi.BidBufferBps(&bind.CallOpts{
+		Context: ctx,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(bidBufferBps), nil
+}
+
+// Get the lowest bid per token that would be accepted on an auction listing.
+//
+// listingId: the ID of the auction listing
+//
+// returns: the reserve price if there are no bids yet, otherwise the winning bid increased by the
+// bid buffer
+//
+// Example
+//
+//	minimumBid, err := marketplace.GetMinimumNextBid(context.Background(), listingId)
+func (marketplace *Marketplace) GetMinimumNextBid(ctx context.Context, listingId int) (*big.Int, error) {
+	listing, err := marketplace.Abi.Listings(&bind.CallOpts{Context: ctx}, big.NewInt(int64(listingId)))
+	if err != nil {
+		return nil, err
+	}
+
+	if listing.AssetContract.String() == zeroAddress {
+		return nil, fmt.Errorf("Failed to find listing with ID %d", listingId)
+	}
+	// Listing type 1 is an auction, bids don't apply to direct listings
+	if listing.ListingType != 1 {
+		return nil, fmt.Errorf("Listing %d is a direct listing, only auction listings accept bids", listingId)
+	}
+
+	winningBid, err := marketplace.Abi.WinningBid(&bind.CallOpts{Context: ctx}, big.NewInt(int64(listingId)))
+	if err != nil {
+		return nil, err
+	}
+
+	if winningBid.Offeror.String() == zeroAddress {
+		return listing.ReservePricePerToken, nil
+	}
+
+	bidBufferBps, err := marketplace.Abi.BidBufferBps(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+
+	return getMinimumNextBid(winningBid.PricePerToken, bidBufferBps), nil
+}
+
+// Get the current winning bid on an auction listing.
+//
+// listingId: the ID of the auction listing
+//
+// returns: the winning bid, or nil if there are no bids yet
+//
+// Example
+//
+//	bid, err := marketplace.GetWinningBid(context.Background(), listingId)
+//	if bid != nil {
+//		fmt.Println(bid.BidderAddress, bid.BidAmountCurrencyValue.DisplayValue)
+//	}
+func (marketplace *Marketplace) GetWinningBid(ctx context.Context, listingId int) (*OfferInfo, error) {
+	listing, err := marketplace.Abi.Listings(&bind.CallOpts{Context: ctx}, big.NewInt(int64(listingId)))
+	if err != nil {
+		return nil, err
+	}
+
+	if listing.AssetContract.String() == zeroAddress {
+		return nil, fmt.Errorf("Failed to find listing with ID %d", listingId)
+	}
+	if listing.ListingType != 1 {
+		return nil, fmt.Errorf("Listing %d is a direct listing, only auction listings accept bids", listingId)
+	}
+
+	winningBid, err := marketplace.Abi.WinningBid(&bind.CallOpts{Context: ctx}, big.NewInt(int64(listingId)))
+	if err != nil {
+		return nil, err
+	}
+
+	if winningBid.Offeror.String() == zeroAddress {
+		return nil, nil
+	}
+
+	return marketplace.mapOffer(ctx, winningBid.Offeror, winningBid.Currency, winningBid.PricePerToken)
+}
+
+// Get the open offers on a direct listing.
+//
+// listingId: the ID of the direct listing
+//
+// returns: the latest unexpired offer of every wallet that made an offer on the listing
+//
+// Example
+//
+//	offers, err := marketplace.GetOffers(context.Background(), listingId)
+func (marketplace *Marketplace) GetOffers(ctx context.Context, listingId int) ([]*OfferInfo, error) {
+	listing, err := marketplace.Abi.Listings(&bind.CallOpts{Context: ctx}, big.NewInt(int64(listingId)))
+	if err != nil {
+		return nil, err
+	}
+
+	if listing.AssetContract.String() == zeroAddress {
+		return nil, fmt.Errorf("Failed to find listing with ID %d", listingId)
+	}
+	if listing.ListingType != 0 {
+		return nil, fmt.Errorf("Listing %d is an auction listing, use GetWinningBid instead", listingId)
+	}
+
+	// Offers are stored per offeror, so the offerors have to be found from the NewOffer events
+	events, err := marketplace.Events.GetEvents(ctx, "NewOffer", EventQueryOptions{
+		Filters: map[string]interface{}{
+			"listingId": big.NewInt(int64(listingId)),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	offers := []*OfferInfo{}
+	seen := map[common.Address]bool{}
+	now := time.Now().Unix()
+	for _, event := range events {
+		offeror := event.Data["offeror"].(common.Address)
+		if seen[offeror] {
+			continue
+		}
+		seen[offeror] = true
+
+		offer, err := marketplace.Abi.Offers(&bind.CallOpts{Context: ctx}, big.NewInt(int64(listingId)), offeror)
+		if err != nil {
+			return nil, err
+		}
+
+		// Accepted and cancelled offers are cleared from storage
+		if offer.Offeror.String() == zeroAddress || offer.ExpirationTimestamp.Int64() < now {
+			continue
+		}
+
+		info, err := marketplace.mapOffer(ctx, offer.Offeror, offer.Currency, offer.PricePerToken)
+		if err != nil {
+			return nil, err
+		}
+		offers = append(offers, info)
+	}
+
+	return offers, nil
+}
+
+// Get the time an auction is extended by when a bid is placed right before it ends.
+//
+// returns: the time buffer in seconds
+func (marketplace *Marketplace) GetTimeBufferInSeconds(ctx context.Context) (int, error) {
+	timeBuffer, err := marketplace.Abi.TimeBuffer(&bind.CallOpts{
+		Context: ctx,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(timeBuffer), nil
+}
+
+// Set the minimum increment required for each new bid on an auction.
+//
+// bps: the bid buffer in basis points (e.g. 500 is 5%)
+//
+// returns: transaction receipt of the update
+//
+// Example
+//
+//	receipt, err := marketplace.SetBidBufferBps(context.Background(), 500)
+func (marketplace *Marketplace) SetBidBufferBps(ctx context.Context, bps int, options ...*TransactionOptions) (*types.Transaction, error) {
+	timeBuffer, err := marketplace.GetTimeBufferInSeconds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return marketplace.setAuctionBuffers(ctx, timeBuffer, bps, options...)
+}
+
+// Set the time an auction is extended by when a bid is placed right before it ends.
+//
+// buffer: the time buffer in seconds
+//
+// returns: transaction receipt of the update
+//
+// Example
+//
+//	receipt, err := marketplace.SetTimeBufferInSeconds(context.Background(), 900)
+func (marketplace *Marketplace) SetTimeBufferInSeconds(ctx context.Context, buffer int, options ...*TransactionOptions) (*types.Transaction, error) {
+	bidBufferBps, err := marketplace.GetBidBufferBps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return marketplace.setAuctionBuffers(ctx, buffer, bidBufferBps, options...)
+}
+
 // Cancel a listing on the marketplace.
 //
+// Only the owner of the listing can cancel it. Auction listings are closed instead.
+//
 // listingId: listing ID to cancel
 //
 // returns: transaction receipt of the cancellation
@@ -169,13 +438,127 @@ func (marketplace *Marketplace) GetTotalCount(ctx context.Context) (int, error)
 //
 //	listingId := 0
 //	receipt, err := marketplace.CancelListing(context.Background(), listingId)
-func (marketplace *Marketplace) CancelListing(ctx context.Context, listingId int) (*types.Transaction, error) {
-	txOpts, err := marketplace.Helper.GetTxOptions(ctx)
+func (marketplace *Marketplace) CancelListing(ctx context.Context, listingId int, options ...*TransactionOptions) (*types.Transaction, error) {
+	listing, err := marketplace.Abi.Listings(&bind.CallOpts{
+		Context: ctx,
+	}, big.NewInt(int64(listingId)))
+	if err != nil {
+		return nil, err
+	}
+
+	if listing.AssetContract.String() == zeroAddress {
+		return nil, fmt.Errorf("Failed to find listing with ID %d", listingId)
+	}
+
+	signerAddress := marketplace.Helper.GetSignerAddress()
+	if listing.TokenOwner != signerAddress {
+		return nil, &NotListingOwnerError{ListingId: listingId, Owner: listing.TokenOwner.String()}
+	}
+
+	txOpts, err := marketplace.Helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
 
-	tx, err := marketplace.Abi.CancelDirectListing(txOpts, big.NewInt(int64(listingId)))
+	var tx *types.Transaction
+	if listing.ListingType == 0 {
+		tx, err = marketplace.Abi.CancelDirectListing(txOpts, big.NewInt(int64(listingId)))
+	} else {
+		tx, err = marketplace.Abi.CloseAuction(txOpts, big.NewInt(int64(listingId)), signerAddress)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return marketplace.Helper.AwaitTx(ctx, tx.Hash())
+}
+
+// Update an existing direct listing on the marketplace.
+//
+// Only the owner of the listing can update it, and auction listings can't be updated.
+//
+// listingId: listing ID to update
+//
+// update: the new values of the listing, fields left empty keep their current value. Changing the
+// currency also requires a new price.
+//
+// returns: transaction receipt of the update
+//
+// Example
+//
+//	update := &thirdweb.UpdateListingInput{
+//		BuyoutPricePerToken: 2, // New price per token of the listing
+//	}
+//
+//	receipt, err := marketplace.UpdateListing(context.Background(), listingId, update)
+func (marketplace *Marketplace) UpdateListing(ctx context.Context, listingId int, update *UpdateListingInput, options ...*TransactionOptions) (*types.Transaction, error) {
+	if update.CurrencyContractAddress != "" && !marketplace.Helper.isValidAddress(update.CurrencyContractAddress) {
+		return nil, ErrInvalidAddress
+	}
+
+	listing, err := marketplace.Abi.Listings(&bind.CallOpts{
+		Context: ctx,
+	}, big.NewInt(int64(listingId)))
+	if err != nil {
+		return nil, err
+	}
+
+	if listing.AssetContract.String() == zeroAddress {
+		return nil, fmt.Errorf("Failed to find listing with ID %d", listingId)
+	}
+
+	if listing.ListingType != 0 {
+		return nil, fmt.Errorf("Listing %d is an auction, which can't be updated", listingId)
+	}
+
+	if listing.TokenOwner != marketplace.Helper.GetSignerAddress() {
+		return nil, &NotListingOwnerError{ListingId: listingId, Owner: listing.TokenOwner.String()}
+	}
+
+	currency := listing.Currency
+	if update.CurrencyContractAddress != "" {
+		currency = common.HexToAddress(update.CurrencyContractAddress)
+	}
+
+	// The current price is in the units of the current currency, which may have other decimals
+	if currency != listing.Currency && update.BuyoutPricePerToken == 0 {
+		return nil, fmt.Errorf("Changing the currency of listing %d requires a new BuyoutPricePerToken", listingId)
+	}
+
+	pricePerToken := listing.BuyoutPricePerToken
+	if update.BuyoutPricePerToken != 0 {
+		pricePerToken, err = normalizePriceValue(
+			ctx,
+			marketplace.Helper.GetProvider(),
+			update.BuyoutPricePerToken,
+			currency.String(),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	quantity := listing.Quantity
+	if update.Quantity != 0 {
+		quantity = big.NewInt(int64(update.Quantity))
+	}
+
+	txOpts, err := marketplace.Helper.GetTxOptions(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	// The contract keeps the current start and end time when they are passed as zero
+	tx, err := marketplace.Abi.UpdateListing(
+		txOpts,
+		big.NewInt(int64(listingId)),
+		quantity,
+		pricePerToken,
+		pricePerToken,
+		currency,
+		big.NewInt(int64(update.StartTimeInEpochSeconds)),
+		big.NewInt(int64(update.ListingDurationInSeconds)),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -190,8 +573,8 @@ func (marketplace *Marketplace) CancelListing(ctx context.Context, listingId int
 // quantityDesired: the quantity of the asset to buy from the listing
 //
 // returns: transaction receipt of the purchase
-func (marketplace *Marketplace) BuyoutListing(ctx context.Context, listingId int, quantityDesired int) (*types.Transaction, error) {
-	return marketplace.BuyoutListingTo(ctx, listingId, quantityDesired, marketplace.Helper.GetSignerAddress().Hex())
+func (marketplace *Marketplace) BuyoutListing(ctx context.Context, listingId int, quantityDesired int, options ...*TransactionOptions) (*types.Transaction, error) {
+	return marketplace.BuyoutListingTo(ctx, listingId, quantityDesired, marketplace.Helper.GetSignerAddress().Hex(), options...)
 }
 
 // Buy a specific listing from the marketplace to a specific address.
@@ -210,7 +593,11 @@ func (marketplace *Marketplace) BuyoutListing(ctx context.Context, listingId int
 //	quantityDesired := 1
 //	receiver := "0x..."
 //	receipt, err := marketplace.BuyoutListingTo(context.Background(), listingId, quantityDesired, receiver)
-func (marketplace *Marketplace) BuyoutListingTo(ctx context.Context, listingId int, quantityDesired int, receiver string) (*types.Transaction, error) {
+func (marketplace *Marketplace) BuyoutListingTo(ctx context.Context, listingId int, quantityDesired int, receiver string, options ...*TransactionOptions) (*types.Transaction, error) {
+	if !marketplace.Helper.isValidAddress(receiver) {
+		return nil, ErrInvalidAddress
+	}
+
 	listing, err := marketplace.validateListing(ctx, listingId)
 	if err != nil {
 		return nil, err
@@ -228,7 +615,7 @@ func (marketplace *Marketplace) BuyoutListingTo(ctx context.Context, listingId i
 	quantity := big.NewInt(int64(quantityDesired))
 	value := listing.BuyoutCurrencyValuePerToken.Value.Mul(listing.BuyoutCurrencyValuePerToken.Value, quantity)
 
-	txOpts, err := marketplace.Helper.GetTxOptions(ctx)
+	txOpts, err := marketplace.Helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -278,9 +665,13 @@ func (marketplace *Marketplace) BuyoutListingTo(ctx context.Context, listingId i
 //	}
 //
 //	listingId, err := marketplace.CreateListing(context.Background(), listing)
-func (marketplace *Marketplace) CreateListing(ctx context.Context, listing *NewDirectListing) (int, error) {
+func (marketplace *Marketplace) CreateListing(ctx context.Context, listing *NewDirectListing, options ...*TransactionOptions) (int, error) {
 	listing.fillDefaults()
 
+	if !marketplace.Helper.isValidAddress(listing.AssetContractAddress) || !marketplace.Helper.isValidAddress(listing.CurrencyContractAddress) {
+		return 0, ErrInvalidAddress
+	}
+
 	err := handleTokenApproval(
 		ctx,
 		marketplace.Helper.GetProvider(),
@@ -304,7 +695,7 @@ func (marketplace *Marketplace) CreateListing(ctx context.Context, listing *NewD
 		return 0, err
 	}
 
-	txOpts, err := marketplace.Helper.GetTxOptions(ctx)
+	txOpts, err := marketplace.Helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return 0, err
 	}
@@ -339,6 +730,35 @@ func (marketplace *Marketplace) CreateListing(ctx context.Context, listing *NewD
 	return 0, errors.New("No ListingAdded event found")
 }
 
+// Both buffers are set by the same contract function, so the caller passes the current value of the other
+func (marketplace *Marketplace) setAuctionBuffers(ctx context.Context, timeBuffer int, bidBufferBps int, options ...*TransactionOptions) (*types.Transaction, error) {
+	txOpts, err := marketplace.Helper.GetTxOptions(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := marketplace.Abi.SetAuctionBuffers(txOpts, big.NewInt(int64(timeBuffer)), big.NewInt(int64(bidBufferBps)))
+	if err != nil {
+		return nil, err
+	}
+
+	return marketplace.Helper.AwaitTx(ctx, tx.Hash())
+}
+
+func (marketplace *Marketplace) mapOffer(ctx context.Context, offeror common.Address, currency common.Address, pricePerToken *big.Int) (*OfferInfo, error) {
+	currencyValue, err := fetchCurrencyValue(ctx, marketplace.Helper.GetProvider(), currency.String(), pricePerToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OfferInfo{
+		BidderAddress:          offeror.String(),
+		CurrencyAddress:        currency.String(),
+		BidAmount:              pricePerToken,
+		BidAmountCurrencyValue: currencyValue,
+	}, nil
+}
+
 func (marketplace *Marketplace) validateListing(ctx context.Context, listingId int) (*DirectListing, error) {
 	listing, err := marketplace.GetListing(ctx, listingId)
 	if err != nil {
@@ -377,6 +797,13 @@ func (marketplace *Marketplace) applyFilter(listings []*DirectListing, filter *M
 		return listings, nil
 	}
 
+	if filter.Seller != "" && !marketplace.Helper.isValidAddress(filter.Seller) {
+		return nil, ErrInvalidAddress
+	}
+	if filter.TokenContract != "" && !marketplace.Helper.isValidAddress(filter.TokenContract) {
+		return nil, ErrInvalidAddress
+	}
+
 	filteredListings := listings
 
 	if filter.Seller != "" {
@@ -428,3 +855,16 @@ func (marketplace *Marketplace) applyFilter(listings []*DirectListing, filter *M
 	filteredListings = filteredListings[start:end]
 	return filteredListings, nil
 }
+
+// The contract only accepts a bid that is higher than the winning bid by at least the buffer, so
+// the increase is rounded up and is at least 1
+func getMinimumNextBid(currentBid *big.Int, bidBufferBps uint64) *big.Int {
+	increase := big.NewInt(0).Mul(currentBid, new(big.Int).SetUint64(bidBufferBps))
+	increase.Add(increase, big.NewInt(9999))
+	increase.Div(increase, big.NewInt(10000))
+	if increase.Sign() == 0 {
+		increase = big.NewInt(1)
+	}
+
+	return big.NewInt(0).Add(currentBid, increase)
+}
@@ -3,6 +3,7 @@ package thirdweb
 import (
 	"context"
 	"encoding/hex"
+	"math/big"
 	"testing"
 	"time"
 
@@ -430,3 +431,12 @@ func TestGenericEncoder(t *testing.T) {
 	assert.Equal(t, toAddress.Hex(), marketplace.Helper.getAddress().Hex())
 	assert.Equal(t, hex.EncodeToString(tx.Data()), "7506c84a0000000000000000000000000000000000000000000000000000000000000000")
 }
+
+func TestGetMinimumNextBid(t *testing.T) {
+	// 5% buffer
+	assert.Equal(t, big.NewInt(105), getMinimumNextBid(big.NewInt(100), 500))
+	// The increase is rounded up so the bid still clears the buffer
+	assert.Equal(t, big.NewInt(11), getMinimumNextBid(big.NewInt(10), 500))
+	// Without a buffer the bid still has to be higher
+	assert.Equal(t, big.NewInt(101), getMinimumNextBid(big.NewInt(100), 0))
+}
@@ -0,0 +1,27 @@
+package thirdweb
+
+import (
+	"time"
+)
+
+// Metrics records how long the SDK spends on RPC calls, waiting for transactions and fetching from
+// IPFS, along with the errors it runs into. Use NewPrometheusMetrics to record them with
+// Prometheus, or implement this interface to back it with another metrics library.
+type Metrics interface {
+	// Called for every RPC call made through the contracts, method is the JSON-RPC method name
+	ObserveRpcCall(method string, duration time.Duration, err error)
+	// Called once a sent transaction is mined, or failed to be
+	ObserveTransactionConfirmation(duration time.Duration, err error)
+	// Called for every file fetched from IPFS
+	ObserveStorageFetch(duration time.Duration, err error)
+}
+
+// Starts timing an RPC call, call the returned function with the error of the call once it's done
+func (handler *ProviderHandler) observeRpcCall(method string) func(err error) {
+	start := time.Now()
+	return func(err error) {
+		if handler.metrics != nil {
+			handler.metrics.ObserveRpcCall(method, time.Since(start), err)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package thirdweb
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type prometheusMetrics struct {
+	rpcDuration     *prometheus.HistogramVec
+	txDuration      prometheus.Histogram
+	storageDuration prometheus.Histogram
+	errors          *prometheus.CounterVec
+}
+
+// NewPrometheusMetrics
+//
+// # Record the metrics of the SDK with Prometheus
+//
+// Registers the thirdweb_rpc_call_duration_seconds (labeled by method),
+// thirdweb_transaction_confirmation_duration_seconds and thirdweb_storage_fetch_duration_seconds
+// histograms, along with the thirdweb_errors_total counter (labeled by source: rpc, transaction or
+// storage).
+//
+// registerer: the registry to register the collectors with, like prometheus.DefaultRegisterer
+//
+// returns: the metrics to set as the Metrics of the SDK options, or an error if the collectors are
+// already registered
+//
+// Example
+//
+//	metrics, err := thirdweb.NewPrometheusMetrics(prometheus.DefaultRegisterer)
+//	sdk, err := thirdweb.NewThirdwebSDK("mumbai", &thirdweb.SDKOptions{
+//		Metrics: metrics,
+//	})
+func NewPrometheusMetrics(registerer prometheus.Registerer) (Metrics, error) {
+	metrics := &prometheusMetrics{
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "thirdweb_rpc_call_duration_seconds",
+			Help: "Duration of the RPC calls made by the thirdweb SDK",
+		}, []string{"method"}),
+		txDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name: "thirdweb_transaction_confirmation_duration_seconds",
+			Help: "Time the thirdweb SDK waited for sent transactions to be mined",
+			// Blocks take seconds to minutes, so the default buckets of up to 10s are too short
+			Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+		}),
+		storageDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name: "thirdweb_storage_fetch_duration_seconds",
+			Help: "Duration of the IPFS fetches made by the thirdweb SDK",
+		}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "thirdweb_errors_total",
+			Help: "Errors returned by RPC calls, transactions and IPFS fetches of the thirdweb SDK",
+		}, []string{"source"}),
+	}
+
+	for _, collector := range []prometheus.Collector{
+		metrics.rpcDuration,
+		metrics.txDuration,
+		metrics.storageDuration,
+		metrics.errors,
+	} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+
+	return metrics, nil
+}
+
+func (m *prometheusMetrics) ObserveRpcCall(method string, duration time.Duration, err error) {
+	m.rpcDuration.WithLabelValues(method).Observe(duration.Seconds())
+	if err != nil {
+		m.errors.WithLabelValues("rpc").Inc()
+	}
+}
+
+func (m *prometheusMetrics) ObserveTransactionConfirmation(duration time.Duration, err error) {
+	m.txDuration.Observe(duration.Seconds())
+	if err != nil {
+		m.errors.WithLabelValues("transaction").Inc()
+	}
+}
+
+func (m *prometheusMetrics) ObserveStorageFetch(duration time.Duration, err error) {
+	m.storageDuration.Observe(duration.Seconds())
+	if err != nil {
+		m.errors.WithLabelValues("storage").Inc()
+	}
+}
@@ -0,0 +1,63 @@
+package thirdweb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockMetrics struct {
+	rpcMethods []string
+}
+
+func (metrics *mockMetrics) ObserveRpcCall(method string, duration time.Duration, err error) {
+	metrics.rpcMethods = append(metrics.rpcMethods, method)
+}
+
+func (metrics *mockMetrics) ObserveTransactionConfirmation(duration time.Duration, err error) {}
+
+func (metrics *mockMetrics) ObserveStorageFetch(duration time.Duration, err error) {}
+
+func TestSendTransactionMetrics(t *testing.T) {
+	handler, err := NewProviderHandler(nil, "")
+	assert.Nil(t, err)
+
+	metrics := &mockMetrics{}
+	handler.UpdateMetrics(metrics)
+	handler.UpdateBroadcaster(&mockBroadcaster{})
+
+	tx := types.NewTx(&types.LegacyTx{Gas: 21000})
+	err = handler.getBackend().SendTransaction(context.Background(), tx)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"eth_sendRawTransaction"}, metrics.rpcMethods)
+}
+
+func TestPrometheusMetrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics, err := NewPrometheusMetrics(registry)
+	assert.Nil(t, err)
+
+	handler, err := NewProviderHandler(nil, "")
+	assert.Nil(t, err)
+	handler.UpdateMetrics(metrics)
+	handler.UpdateBroadcaster(&mockBroadcaster{})
+
+	tx := types.NewTx(&types.LegacyTx{Gas: 21000})
+	err = handler.getBackend().SendTransaction(context.Background(), tx)
+	assert.Nil(t, err)
+	metrics.ObserveStorageFetch(time.Second, &ErrGatewayTimeout{URL: "ipfs://QmHash"})
+
+	count, err := testutil.GatherAndCount(registry, "thirdweb_rpc_call_duration_seconds", "thirdweb_storage_fetch_duration_seconds")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.(*prometheusMetrics).errors.WithLabelValues("storage")))
+
+	// Registering twice with the same registry fails instead of panicking
+	_, err = NewPrometheusMetrics(registry)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,161 @@
+package thirdweb
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Options of the TypeScript SDK that carry over to the Go SDK
+var migratedConfigOptions = []string{
+	"chain",
+	"chainId",
+	"readonlySettings.rpcUrl",
+	"readonlySettings.chainId",
+	"gatewayUrls",
+	"gasless.biconomy.apiKey",
+	"gasless.biconomy.apiId",
+	"gasless.biconomy.deadlineSeconds",
+	"gasless.openzeppelin.relayerUrl",
+	"gasless.openzeppelin.relayerForwarderAddress",
+}
+
+// ValidateMigratedConfig
+//
+// # Check that a config exported from the TypeScript SDK can be used with the Go SDK
+//
+// This is meant to help teams moving a backend from the TypeScript SDK to the Go SDK. It reads
+// the JSON of the SDK options and reports every option, chain and address that the Go SDK can't
+// represent.
+//
+// tsConfigJSON: the JSON encoded options of the TypeScript SDK
+//
+// returns: a warning for every part of the config that doesn't carry over as is
+//
+// Example
+//
+//	warnings, err := sdk.ValidateMigratedConfig(`{"chainId": 137, "gasSettings": {"maxPriceInGwei": 500}}`)
+//	for _, warning := range warnings {
+//		fmt.Printf("%s: %s\n", warning.Field, warning.Message)
+//	}
+func (sdk *ThirdwebSDK) ValidateMigratedConfig(tsConfigJSON string) ([]ValidationWarning, error) {
+	config := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(tsConfigJSON), &config); err != nil {
+		return nil, err
+	}
+
+	warnings := validateMigratedOptions("", config)
+	warnings = append(warnings, validateMigratedAddresses("", config)...)
+
+	sort.SliceStable(warnings, func(i, j int) bool {
+		return warnings[i].Field < warnings[j].Field
+	})
+	return warnings, nil
+}
+
+func validateMigratedOptions(field string, value interface{}) []ValidationWarning {
+	warnings := []ValidationWarning{}
+	warn := func(format string, args ...interface{}) {
+		warnings = append(warnings, ValidationWarning{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	switch field {
+	case "chainId", "readonlySettings.chainId":
+		if chainId, ok := value.(float64); !ok || !isSupportedChainId(ChainID(chainId)) {
+			warn("Chain ID %v is not supported by the Go SDK", value)
+		}
+		return warnings
+	case "chain":
+		switch chain := value.(type) {
+		case string:
+			if _, err := getDefaultRpcUrl(chain); err != nil {
+				warn("Chain '%s' is not supported by name, pass its RPC URL to NewThirdwebSDK instead", chain)
+			}
+		case float64:
+			return validateMigratedOptions("chainId", chain)
+		case map[string]interface{}:
+			return validateMigratedOptions("chainId", chain["chainId"])
+		default:
+			warn("Chain must be a chain name, a chain ID or a chain object")
+		}
+		return warnings
+	case "gatewayUrls":
+		if urls, ok := value.([]interface{}); ok && len(urls) > 1 {
+			warn("Only one IPFS gateway can be set with SDKOptions.GatewayUrl, the others will be ignored")
+		}
+		return warnings
+	case "gasless.openzeppelin.useEOAForwarder", "gasless.openzeppelin.domainName", "gasless.openzeppelin.domainVersion":
+		warn("Only the thirdweb forwarder is supported with OpenZeppelin Defender, this option will be ignored")
+		return warnings
+	}
+
+	if field != "" && !isMigratedOption(field) {
+		warn("Option has no equivalent in the Go SDK and will be ignored")
+		return warnings
+	}
+
+	if options, ok := value.(map[string]interface{}); ok {
+		for key, nested := range options {
+			nestedField := key
+			if field != "" {
+				nestedField = field + "." + key
+			}
+			warnings = append(warnings, validateMigratedOptions(nestedField, nested)...)
+		}
+	}
+
+	return warnings
+}
+
+// Either an option that carries over, or an object that contains one
+func isMigratedOption(field string) bool {
+	for _, option := range migratedConfigOptions {
+		if option == field || strings.HasPrefix(option, field+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// The Go SDK only takes hex addresses, so ENS names and malformed addresses anywhere in the config
+// need to be replaced
+func validateMigratedAddresses(field string, value interface{}) []ValidationWarning {
+	warnings := []ValidationWarning{}
+
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range typed {
+			nestedField := key
+			if field != "" {
+				nestedField = field + "." + key
+			}
+			warnings = append(warnings, validateMigratedAddresses(nestedField, nested)...)
+		}
+	case []interface{}:
+		for i, nested := range typed {
+			warnings = append(warnings, validateMigratedAddresses(fmt.Sprintf("%s[%d]", field, i), nested)...)
+		}
+	case string:
+		if strings.HasSuffix(typed, ".eth") {
+			warnings = append(warnings, ValidationWarning{
+				Field:   field,
+				Message: fmt.Sprintf("ENS names are not resolved by the Go SDK, use the address '%s' resolves to instead", typed),
+			})
+		} else if strings.HasPrefix(typed, "0x") && len(typed) == 42 && !common.IsHexAddress(typed) {
+			warnings = append(warnings, ValidationWarning{
+				Field:   field,
+				Message: fmt.Sprintf("'%s' is not a valid address", typed),
+			})
+		}
+	}
+
+	return warnings
+}
+
+func isSupportedChainId(chainId ChainID) bool {
+	_, err := getContractAddressByChainId(chainId, "TWFactory")
+	return err == nil
+}
@@ -0,0 +1,33 @@
+package thirdweb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMigratedConfig(t *testing.T) {
+	sdk := &ThirdwebSDK{}
+
+	warnings, err := sdk.ValidateMigratedConfig(`{
+		"chainId": 137,
+		"gatewayUrls": ["https://gateway.ipfscdn.io/ipfs/"],
+		"gasless": {"biconomy": {"apiKey": "key", "apiId": "id"}}
+	}`)
+	assert.Nil(t, err)
+	assert.Empty(t, warnings)
+
+	warnings, err = sdk.ValidateMigratedConfig(`{
+		"chainId": 424242,
+		"gasSettings": {"maxPriceInGwei": 500},
+		"gasless": {"openzeppelin": {"relayerUrl": "https://relayer", "relayerForwarderAddress": "forwarder.eth"}}
+	}`)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(warnings))
+	assert.Equal(t, "chainId", warnings[0].Field)
+	assert.Equal(t, "gasSettings", warnings[1].Field)
+	assert.Equal(t, "gasless.openzeppelin.relayerForwarderAddress", warnings[2].Field)
+
+	_, err = sdk.ValidateMigratedConfig("not json")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,82 @@
+package thirdweb
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	signerTypes "github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// Returns the EIP-712 hash that is signed for the typed data
+func hashTypedData(typedData signerTypes.TypedData) ([]byte, error) {
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return nil, err
+	}
+
+	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
+	return crypto.Keccak256(rawData), nil
+}
+
+// Signs the typed data of a mint request, returning the hex encoded signature expected by
+// mintWithSignature
+func signMintRequest(typedData signerTypes.TypedData, privateKey *ecdsa.PrivateKey) (string, error) {
+	if privateKey == nil {
+		return "", ErrNoSigner
+	}
+
+	sigHash, err := hashTypedData(typedData)
+	if err != nil {
+		return "", err
+	}
+
+	signatureBytes, err := crypto.Sign(sigHash, privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	// We need this to correct v = 0,1 to v = 27,28 - or else all will break
+	if signatureBytes[64] == 0 || signatureBytes[64] == 1 {
+		signatureBytes[64] += 27
+	}
+
+	return "0x" + hex.EncodeToString(signatureBytes), nil
+}
+
+// Recovers the address that signed the typed data, without any RPC call. The signature is the hex
+// encoded signature of a signed payload.
+func recoverTypedDataSigner(typedData signerTypes.TypedData, signature string) (common.Address, error) {
+	signatureBytes, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(signatureBytes) != 65 {
+		return common.Address{}, fmt.Errorf("Signature should be 65 bytes, but is %d bytes", len(signatureBytes))
+	}
+
+	// Support both formats of recovery bit (27/28 or 0/1)
+	if signatureBytes[64] == 27 || signatureBytes[64] == 28 {
+		signatureBytes[64] -= 27
+	}
+
+	sigHash, err := hashTypedData(typedData)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	publicKey, err := crypto.SigToPub(sigHash, signatureBytes)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*publicKey), nil
+}
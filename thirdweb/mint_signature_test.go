@@ -0,0 +1,65 @@
+package thirdweb
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	signerTypes "github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func signTestTypedData(t *testing.T, payload *Signature1155PayloadOutput) (string, string) {
+	privateKey, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+
+	typedData, err := newMintRequest1155TypedData(big.NewInt(1), "0x71C7656EC7ab88b098defB751B7401B5f6d8976F", payload)
+	assert.Nil(t, err)
+
+	signature, err := signMintRequest(typedData, privateKey)
+	assert.Nil(t, err)
+
+	return signature, crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+}
+
+func newTestMintRequest1155TypedData(t *testing.T, chainId int64, payload *Signature1155PayloadOutput) signerTypes.TypedData {
+	typedData, err := newMintRequest1155TypedData(big.NewInt(chainId), "0x71C7656EC7ab88b098defB751B7401B5f6d8976F", payload)
+	assert.Nil(t, err)
+	return typedData
+}
+
+func TestRecoverTypedDataSigner(t *testing.T) {
+	payload := &Signature1155PayloadOutput{
+		To:                   "0x71C7656EC7ab88b098defB751B7401B5f6d8976F",
+		Price:                "0",
+		CurrencyAddress:      zeroAddress,
+		PrimarySaleRecipient: zeroAddress,
+		RoyaltyRecipient:     zeroAddress,
+		TokenId:              -1,
+		Quantity:             1,
+		Uri:                  "ipfs://uri/0",
+	}
+	signature, signer := signTestTypedData(t, payload)
+
+	recovered, err := recoverTypedDataSigner(newTestMintRequest1155TypedData(t, 1, payload), signature)
+	assert.Nil(t, err)
+	assert.Equal(t, signer, recovered.Hex())
+
+	// Changing the payload or the chain changes the hash, so another address is recovered
+	tampered := *payload
+	tampered.Quantity = 100
+	recovered, err = recoverTypedDataSigner(newTestMintRequest1155TypedData(t, 1, &tampered), signature)
+	assert.Nil(t, err)
+	assert.NotEqual(t, signer, recovered.Hex())
+
+	recovered, err = recoverTypedDataSigner(newTestMintRequest1155TypedData(t, 137, payload), signature)
+	assert.Nil(t, err)
+	assert.NotEqual(t, signer, recovered.Hex())
+
+	_, err = recoverTypedDataSigner(newTestMintRequest1155TypedData(t, 1, payload), "0x1234")
+	assert.NotNil(t, err)
+
+	_, err = signMintRequest(newTestMintRequest1155TypedData(t, 1, payload), nil)
+	assert.True(t, errors.Is(err, ErrNoSigner))
+}
@@ -0,0 +1,128 @@
+package thirdweb
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	ethAbi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/thirdweb-dev/go-sdk/v2/abi"
+)
+
+// Multicall3 is deployed at the same address on most chains
+// https://github.com/mds1/multicall#multicall3-contract-addresses
+const multicall3Address = "0xcA11bde05977b3631167028862bE2a173976CA11"
+
+const multicall3Abi = `[{
+	"type": "function",
+	"name": "aggregate3",
+	"stateMutability": "payable",
+	"inputs": [{
+		"name": "calls",
+		"type": "tuple[]",
+		"components": [
+			{"name": "target", "type": "address"},
+			{"name": "allowFailure", "type": "bool"},
+			{"name": "callData", "type": "bytes"}
+		]
+	}],
+	"outputs": [{
+		"name": "returnData",
+		"type": "tuple[]",
+		"components": [
+			{"name": "success", "type": "bool"},
+			{"name": "returnData", "type": "bytes"}
+		]
+	}]
+}, {
+	"type": "function",
+	"name": "balanceOf",
+	"stateMutability": "view",
+	"inputs": [{"name": "account", "type": "address"}],
+	"outputs": [{"name": "", "type": "uint256"}]
+}]`
+
+type multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// Returns the ERC20 balance of the owner for each token, in the same order, with a single eth_call
+// through Multicall3. Tokens whose balanceOf call fails get a nil balance.
+func getERC20BalancesWithMulticall(ctx context.Context, provider *ethclient.Client, owner string, tokenAddresses []string) ([]*big.Int, error) {
+	// The balanceOf function is in the same ABI so we can encode its calls without another parse
+	multicallAbi, err := ethAbi.JSON(strings.NewReader(multicall3Abi))
+	if err != nil {
+		return nil, err
+	}
+
+	balanceOfData, err := multicallAbi.Pack("balanceOf", common.HexToAddress(owner))
+	if err != nil {
+		return nil, err
+	}
+
+	calls := make([]multicall3Call, len(tokenAddresses))
+	for i, tokenAddress := range tokenAddresses {
+		calls[i] = multicall3Call{
+			Target:       common.HexToAddress(tokenAddress),
+			AllowFailure: true,
+			CallData:     balanceOfData,
+		}
+	}
+
+	data, err := multicallAbi.Pack("aggregate3", calls)
+	if err != nil {
+		return nil, err
+	}
+
+	multicall := common.HexToAddress(multicall3Address)
+	result, err := provider.CallContract(ctx, ethereum.CallMsg{To: &multicall, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := multicallAbi.Unpack("aggregate3", result)
+	if err != nil {
+		return nil, err
+	}
+	results := *ethAbi.ConvertType(values[0], new([]multicall3Result)).(*[]multicall3Result)
+
+	balances := make([]*big.Int, len(tokenAddresses))
+	for i, callResult := range results {
+		if !callResult.Success || len(callResult.ReturnData) != 32 {
+			continue
+		}
+		balances[i] = new(big.Int).SetBytes(callResult.ReturnData)
+	}
+
+	return balances, nil
+}
+
+// Same as getERC20BalancesWithMulticall, for chains where Multicall3 isn't deployed
+func getERC20Balances(ctx context.Context, provider *ethclient.Client, owner string, tokenAddresses []string) ([]*big.Int, error) {
+	balances := make([]*big.Int, len(tokenAddresses))
+	for i, tokenAddress := range tokenAddresses {
+		token, err := abi.NewIERC20(common.HexToAddress(tokenAddress), provider)
+		if err != nil {
+			return nil, err
+		}
+
+		balances[i], err = token.BalanceOf(&bind.CallOpts{Context: ctx}, common.HexToAddress(owner))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return balances, nil
+}
@@ -0,0 +1,82 @@
+package thirdweb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ethAbi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWalletBalanceBatchesTokenBalances(t *testing.T) {
+	multicallAbi, err := ethAbi.JSON(strings.NewReader(multicall3Abi))
+	assert.Nil(t, err)
+
+	heldToken := "0x0000000000000000000000000000000000000001"
+	failingToken := "0x0000000000000000000000000000000000000002"
+
+	multicalls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Id     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var result interface{}
+		switch req.Method {
+		case "eth_chainId":
+			result = "0x1"
+		case "eth_getBalance":
+			result = "0x2a"
+		case "eth_call":
+			var call struct {
+				To common.Address `json:"to"`
+			}
+			assert.Nil(t, json.Unmarshal(req.Params[0], &call))
+
+			if call.To == common.HexToAddress(multicall3Address) {
+				multicalls += 1
+				encoded, err := multicallAbi.Methods["aggregate3"].Outputs.Pack([]multicall3Result{
+					{Success: true, ReturnData: common.LeftPadBytes(big.NewInt(500).Bytes(), 32)},
+					{Success: false, ReturnData: []byte{}},
+				})
+				assert.Nil(t, err)
+				result = hexutil.Encode(encoded)
+			} else {
+				// The currency metadata of the held token, every call answers with 2 decimals
+				assert.Equal(t, common.HexToAddress(heldToken), call.To)
+				result = hexutil.Encode(common.LeftPadBytes(big.NewInt(2).Bytes(), 32))
+			}
+		default:
+			t.Fatalf("Unexpected RPC method %s", req.Method)
+		}
+
+		encoded, err := json.Marshal(result)
+		assert.Nil(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":%s}`, req.Id, encoded)))
+	}))
+	defer server.Close()
+
+	sdk, err := NewThirdwebSDK(server.URL, nil)
+	assert.Nil(t, err)
+
+	balance, err := sdk.GetWalletBalance(context.Background(), "0x0000000000000000000000000000000000000003", heldToken, failingToken)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, multicalls)
+	assert.Equal(t, big.NewInt(42), balance.Native.Value)
+	assert.Equal(t, 1, len(balance.Tokens))
+	assert.Equal(t, heldToken, balance.Tokens[0].ContractAddress)
+	assert.Equal(t, big.NewInt(500), balance.Tokens[0].Balance.Value)
+	assert.Equal(t, float64(5), balance.Tokens[0].Balance.DisplayValue)
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 
 	"github.com/thirdweb-dev/go-sdk/v2/abi"
 )
@@ -34,14 +33,16 @@ type Multiwrap struct {
 	Encoder *ContractEncoder
 }
 
-func newMultiwrap(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*Multiwrap, error) {
-	if contractAbi, err := abi.NewMultiwrap(address, provider); err != nil {
+func newMultiwrap(handler *ProviderHandler, address common.Address, storage storage) (*Multiwrap, error) {
+	backend := handler.getBackend()
+
+	if contractAbi, err := abi.NewMultiwrap(address, backend); err != nil {
 		return nil, err
 	} else {
-		if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+		if helper, err := newContractHelper(address, handler); err != nil {
 			return nil, err
 		} else {
-			if erc721, err := newERC721Standard(provider, address, privateKey, storage); err != nil {
+			if erc721, err := newERC721Standard(handler, address, storage); err != nil {
 				return nil, err
 			} else {
 				encoder, err := newContractEncoder(abi.MultiwrapABI, helper)
@@ -159,7 +160,7 @@ func (multiwrap *Multiwrap) GetWrappedContents(wrappedTokenId int) (*MultiwrapBu
 //
 //	// This will mint the wrapped token to the connected wallet
 //	tx, err := contract.Wrap(context.Background(), contents, wrappedTokenMetadata, "")
-func (multiwrap *Multiwrap) Wrap(ctx context.Context, contents *MultiwrapBundle, wrappedTokenMetadata interface{}, recipientAddress string) (*types.Transaction, error) {
+func (multiwrap *Multiwrap) Wrap(ctx context.Context, contents *MultiwrapBundle, wrappedTokenMetadata interface{}, recipientAddress string, options ...*TransactionOptions) (*types.Transaction, error) {
 	uri, ok := wrappedTokenMetadata.(string)
 	if !ok {
 		tokenMetadata, ok := wrappedTokenMetadata.(*NFTMetadataInput)
@@ -184,7 +185,7 @@ func (multiwrap *Multiwrap) Wrap(ctx context.Context, contents *MultiwrapBundle,
 		return nil, err
 	}
 
-	txOpts, err := multiwrap.Helper.GetTxOptions(ctx)
+	txOpts, err := multiwrap.Helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -208,12 +209,12 @@ func (multiwrap *Multiwrap) Wrap(ctx context.Context, contents *MultiwrapBundle,
 //
 //	tokenId := 0
 //	tx, err := contract.Unwrap(context.Background(), tokenId, "")
-func (multiwrap *Multiwrap) Unwrap(ctx context.Context, wrappedTokenId int, recipientAddress string) (*types.Transaction, error) {
+func (multiwrap *Multiwrap) Unwrap(ctx context.Context, wrappedTokenId int, recipientAddress string, options ...*TransactionOptions) (*types.Transaction, error) {
 	if recipientAddress == "" {
 		recipientAddress = multiwrap.Helper.GetSignerAddress().String()
 	}
 
-	txOpts, err := multiwrap.Helper.GetTxOptions(ctx)
+	txOpts, err := multiwrap.Helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -7,7 +7,6 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 
 	"github.com/thirdweb-dev/go-sdk/v2/abi"
 )
@@ -34,17 +33,19 @@ type NFTCollection struct {
 	Events    *ContractEvents
 }
 
-func newNFTCollection(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*NFTCollection, error) {
-	if contractAbi, err := abi.NewTokenERC721(address, provider); err != nil {
+func newNFTCollection(handler *ProviderHandler, address common.Address, storage storage) (*NFTCollection, error) {
+	backend := handler.getBackend()
+
+	if contractAbi, err := abi.NewTokenERC721(address, backend); err != nil {
 		return nil, err
 	} else {
-		if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+		if helper, err := newContractHelper(address, handler); err != nil {
 			return nil, err
 		} else {
-			if erc721, err := newERC721Standard(provider, address, privateKey, storage); err != nil {
+			if erc721, err := newERC721Standard(handler, address, storage); err != nil {
 				return nil, err
 			} else {
-				signature, err := newERC721SignatureMinting(provider, address, privateKey, storage)
+				signature, err := newERC721SignatureMinting(handler, address, storage)
 				if err != nil {
 					return nil, err
 				}
@@ -126,8 +127,8 @@ func (nft *NFTCollection) GetOwnedTokenIDs(ctx context.Context, address string)
 // metadata: metadata of the NFT to mint
 //
 // returns: the transaction receipt of the mint
-func (nft *NFTCollection) Mint(ctx context.Context, metadata *NFTMetadataInput) (*types.Transaction, error) {
-	return nft.erc721.Mint(ctx, metadata)
+func (nft *NFTCollection) Mint(ctx context.Context, metadata *NFTMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
+	return nft.erc721.Mint(ctx, metadata, options...)
 }
 
 // Mint a new NFT to the specified wallet.
@@ -150,8 +151,8 @@ func (nft *NFTCollection) Mint(ctx context.Context, metadata *NFTMetadataInput)
 //	}
 //
 //	tx, err := contract.MintTo(context.Background(), "{{wallet_address}}", metadata)
-func (nft *NFTCollection) MintTo(ctx context.Context, address string, metadata *NFTMetadataInput) (*types.Transaction, error) {
-	return nft.erc721.MintTo(ctx, address, metadata)
+func (nft *NFTCollection) MintTo(ctx context.Context, address string, metadata *NFTMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
+	return nft.erc721.MintTo(ctx, address, metadata, options...)
 }
 
 // Mint a batch of new NFTs to the connected wallet.
@@ -159,8 +160,8 @@ func (nft *NFTCollection) MintTo(ctx context.Context, address string, metadata *
 // metadatas: list of metadata of the NFTs to mint
 //
 // returns: the transaction receipt of the mint
-func (nft *NFTCollection) MintBatch(ctx context.Context, metadatas []*NFTMetadataInput) (*types.Transaction, error) {
-	return nft.erc721.MintBatch(ctx, metadatas)
+func (nft *NFTCollection) MintBatch(ctx context.Context, metadatas []*NFTMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
+	return nft.erc721.MintBatch(ctx, metadatas, options...)
 }
 
 // Mint a batch of new NFTs to the specified wallet.
@@ -185,6 +186,6 @@ func (nft *NFTCollection) MintBatch(ctx context.Context, metadatas []*NFTMetadat
 //	}
 //
 //	tx, err := contract.MintBatchTo(context.Background(), "{{wallet_address}}", metadatas)
-func (nft *NFTCollection) MintBatchTo(ctx context.Context, address string, metadatas []*NFTMetadataInput) (*types.Transaction, error) {
-	return nft.erc721.MintBatchTo(ctx, address, metadatas)
+func (nft *NFTCollection) MintBatchTo(ctx context.Context, address string, metadatas []*NFTMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
+	return nft.erc721.MintBatchTo(ctx, address, metadatas, options...)
 }
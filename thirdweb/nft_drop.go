@@ -8,7 +8,6 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 
 	"github.com/thirdweb-dev/go-sdk/v2/abi"
 )
@@ -35,17 +34,19 @@ type NFTDrop struct {
 	Events          *ContractEvents
 }
 
-func newNFTDrop(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*NFTDrop, error) {
-	if contractAbi, err := abi.NewDropERC721(address, provider); err != nil {
+func newNFTDrop(handler *ProviderHandler, address common.Address, storage storage) (*NFTDrop, error) {
+	backend := handler.getBackend()
+
+	if contractAbi, err := abi.NewDropERC721(address, backend); err != nil {
 		return nil, err
 	} else {
-		if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+		if helper, err := newContractHelper(address, handler); err != nil {
 			return nil, err
 		} else {
-			if erc721, err := newERC721Standard(provider, address, privateKey, storage); err != nil {
+			if erc721, err := newERC721Standard(handler, address, storage); err != nil {
 				return nil, err
 			} else {
-				claimConditions, err := newNFTDropClaimConditions(address, provider, helper, storage)
+				claimConditions, err := newNFTDropClaimConditions(address, backend, helper, storage)
 				if err != nil {
 					return nil, err
 				}
@@ -151,6 +152,17 @@ func (drop *NFTDrop) GetAllUnclaimed(ctx context.Context) ([]*NFTMetadata, error
 	return drop.erc721.GetAllUnclaimed(ctx)
 }
 
+// Set how many NFTs GetAllClaimed and GetAllUnclaimed fetch concurrently, defaults to 50.
+//
+// pageSize: the number of NFTs to fetch at a time
+//
+// Example
+//
+//	contract.SetPageSize(10)
+func (drop *NFTDrop) SetPageSize(pageSize int) {
+	drop.erc721.SetPageSize(pageSize)
+}
+
 // Get the total number of NFTs that have been claimed.
 func (drop *NFTDrop) TotalClaimedSupply(ctx context.Context) (int, error) {
 	return drop.erc721.TotalClaimedSupply(ctx)
@@ -161,6 +173,40 @@ func (drop *NFTDrop) TotalUnclaimedSupply(ctx context.Context) (int, error) {
 	return drop.erc721.TotalUnclaimedSupply(ctx)
 }
 
+// Get the number of NFTs that have been claimed, without fetching their metadata. Same as
+// TotalClaimedSupply, as a big.Int.
+//
+// returns: the number of claimed NFTs
+//
+// Example
+//
+//	claimed, err := contract.GetClaimedSupply(context.Background())
+func (drop *NFTDrop) GetClaimedSupply(ctx context.Context) (*big.Int, error) {
+	claimed, err := drop.TotalClaimedSupply(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return big.NewInt(int64(claimed)), nil
+}
+
+// Get the number of lazy minted NFTs that are still left to claim, without fetching their
+// metadata. Same as TotalUnclaimedSupply, as a big.Int.
+//
+// returns: the number of unclaimed NFTs
+//
+// Example
+//
+//	unclaimed, err := contract.GetUnclaimedSupply(context.Background())
+func (drop *NFTDrop) GetUnclaimedSupply(ctx context.Context) (*big.Int, error) {
+	unclaimed, err := drop.TotalUnclaimedSupply(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return big.NewInt(int64(unclaimed)), nil
+}
+
 func (drop *NFTDrop) GetTotalClaimed(ctx context.Context, address string) (*big.Int, error) {
 	events, err := drop.Events.GetEvents(ctx, "TokensClaimed", EventQueryOptions{
 		Filters: map[string]interface{}{
@@ -215,8 +261,8 @@ func (drop *NFTDrop) GetClaimIneligibilityReasons(ctx context.Context, quantity
 //	}
 //
 //	tx, err := contract.CreateBatch(context.Background(), metadatas)
-func (drop *NFTDrop) CreateBatch(ctx context.Context, metadatas []*NFTMetadataInput) (*types.Transaction, error) {
-	return drop.erc721.CreateBatch(ctx, metadatas)
+func (drop *NFTDrop) CreateBatch(ctx context.Context, metadatas []*NFTMetadataInput, options ...*TransactionOptions) (*types.Transaction, error) {
+	return drop.erc721.CreateBatch(ctx, metadatas, options...)
 }
 
 // Claim NFTs from this contract to the connect wallet.
@@ -224,8 +270,8 @@ func (drop *NFTDrop) CreateBatch(ctx context.Context, metadatas []*NFTMetadataIn
 // quantity: the number of NFTs to claim
 //
 // returns: the transaction receipt of the claim
-func (drop *NFTDrop) Claim(ctx context.Context, quantity int) (*types.Transaction, error) {
-	return drop.erc721.Claim(ctx, quantity)
+func (drop *NFTDrop) Claim(ctx context.Context, quantity int, options ...*TransactionOptions) (*types.Transaction, error) {
+	return drop.erc721.Claim(ctx, quantity, options...)
 }
 
 // Claim NFTs from this contract to the connect wallet.
@@ -242,8 +288,8 @@ func (drop *NFTDrop) Claim(ctx context.Context, quantity int) (*types.Transactio
 //	quantity = 1
 //
 //	tx, err := contract.ClaimTo(context.Background(), address, quantity)
-func (drop *NFTDrop) ClaimTo(ctx context.Context, destinationAddress string, quantity int) (*types.Transaction, error) {
-	return drop.erc721.ClaimTo(ctx, destinationAddress, quantity)
+func (drop *NFTDrop) ClaimTo(ctx context.Context, destinationAddress string, quantity int, options ...*TransactionOptions) (*types.Transaction, error) {
+	return drop.erc721.ClaimTo(ctx, destinationAddress, quantity, options...)
 }
 
 func (drop *NFTDrop) GetClaimArguments(
@@ -9,7 +9,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 
 	"github.com/thirdweb-dev/go-sdk/v2/abi"
 )
@@ -22,8 +21,8 @@ type NFTDropClaimConditions struct {
 	storage storage
 }
 
-func newNFTDropClaimConditions(address common.Address, provider *ethclient.Client, helper *contractHelper, storage storage) (*NFTDropClaimConditions, error) {
-	if contractAbi, err := abi.NewDropERC721(address, provider); err != nil {
+func newNFTDropClaimConditions(address common.Address, backend bind.ContractBackend, helper *contractHelper, storage storage) (*NFTDropClaimConditions, error) {
+	if contractAbi, err := abi.NewDropERC721(address, backend); err != nil {
 		return nil, err
 	} else {
 		claimConditions := &NFTDropClaimConditions{
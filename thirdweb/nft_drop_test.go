@@ -2,7 +2,9 @@ package thirdweb
 
 import (
 	"context"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -41,3 +43,86 @@ func TestCreateBatchNftDrop(t *testing.T) {
 	assert.Equal(t, nfts[0].Name, "NFT 1")
 	assert.Equal(t, nfts[1].Name, "NFT 2")
 }
+
+func TestForEachTokenInPages(t *testing.T) {
+	var mu sync.Mutex
+	running := 0
+	started := make(chan int)
+	release := make(chan struct{})
+
+	done := make(chan error)
+	go func() {
+		done <- forEachTokenInPages(context.Background(), nil, 3, 10, 3, func(ctx context.Context, tokenId int) {
+			mu.Lock()
+			running += 1
+			mu.Unlock()
+
+			started <- tokenId
+			<-release
+
+			mu.Lock()
+			running -= 1
+			mu.Unlock()
+		})
+	}()
+
+	visited := map[int]bool{}
+	for _, pageSize := range []int{3, 3, 1} {
+		for i := 0; i < pageSize; i++ {
+			visited[<-started] = true
+		}
+
+		// The whole page is parked, and the next page doesn't start until it's released
+		select {
+		case tokenId := <-started:
+			t.Fatalf("Token %d started before the previous page finished", tokenId)
+		case <-time.After(20 * time.Millisecond):
+		}
+		mu.Lock()
+		assert.Equal(t, pageSize, running)
+		mu.Unlock()
+
+		for i := 0; i < pageSize; i++ {
+			release <- struct{}{}
+		}
+	}
+
+	assert.Nil(t, <-done)
+	assert.Equal(t, 7, len(visited))
+	assert.False(t, visited[2])
+	assert.True(t, visited[9])
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := forEachTokenInPages(ctx, nil, 0, 10, 3, func(ctx context.Context, tokenId int) {})
+	assert.NotNil(t, err)
+}
+
+func TestForEachTokenInPagesSharesLimiter(t *testing.T) {
+	started := make(chan int)
+	release := make(chan struct{})
+
+	// The limiter only has 2 slots, so it caps a page of 3
+	done := make(chan error)
+	go func() {
+		done <- forEachTokenInPages(context.Background(), newConcurrencyLimiter(2), 0, 3, 3, func(ctx context.Context, tokenId int) {
+			started <- tokenId
+			<-release
+		})
+	}()
+
+	<-started
+	<-started
+	select {
+	case tokenId := <-started:
+		t.Fatalf("Token %d started without a free slot", tokenId)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	release <- struct{}{}
+	<-started
+	release <- struct{}{}
+	release <- struct{}{}
+
+	assert.Nil(t, <-done)
+}
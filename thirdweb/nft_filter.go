@@ -0,0 +1,86 @@
+package thirdweb
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Fetches the metadata of the tokens that passed the on-chain owner check, then applies the
+// attribute filter and result limit in memory
+func fetchFilteredNFTs(
+	ctx context.Context,
+	limiter *concurrencyLimiter,
+	tokenIds []int,
+	filter *NFTFilter,
+	fetch func(ctx context.Context, tokenId int) (*NFTMetadata, error),
+) ([]*NFTMetadata, error) {
+	// Without an attribute filter every fetched token is a result, so only fetch as many as needed
+	if filter.HasAttribute == nil && filter.MaxResults > 0 && len(tokenIds) > filter.MaxResults {
+		tokenIds = tokenIds[:filter.MaxResults]
+	}
+
+	metadatas := make([]*NFTMetadata, len(tokenIds))
+	errs := make([]error, len(tokenIds))
+	var wg sync.WaitGroup
+	for i, tokenId := range tokenIds {
+		i, tokenId := i, tokenId
+		wg.Add(1)
+		limiter.Go(func() {
+			defer wg.Done()
+			metadatas[i], errs[i] = fetch(ctx, tokenId)
+		})
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	nfts := []*NFTMetadata{}
+	for _, metadata := range metadatas {
+		if filter.HasAttribute != nil && !hasAttribute(metadata, filter.HasAttribute) {
+			continue
+		}
+
+		nfts = append(nfts, metadata)
+		if filter.MaxResults > 0 && len(nfts) == filter.MaxResults {
+			break
+		}
+	}
+
+	return nfts, nil
+}
+
+// Attributes are usually a list of {"trait_type": ..., "value": ...} objects, but some collections
+// store them as a single object keyed by trait type
+func hasAttribute(metadata *NFTMetadata, filter *AttributeFilter) bool {
+	switch attributes := metadata.Attributes.(type) {
+	case []interface{}:
+		for _, attribute := range attributes {
+			if trait, ok := attribute.(map[string]interface{}); ok {
+				if traitType, ok := trait["trait_type"].(string); ok && traitType == filter.TraitType {
+					if attributeValueMatches(trait["value"], filter.Value) {
+						return true
+					}
+				}
+			}
+		}
+	case map[string]interface{}:
+		if value, ok := attributes[filter.TraitType]; ok {
+			return attributeValueMatches(value, filter.Value)
+		}
+	}
+
+	return false
+}
+
+// Values are compared by their string form since JSON numbers are decoded as float64
+func attributeValueMatches(value interface{}, expected interface{}) bool {
+	if expected == nil {
+		return true
+	}
+	return fmt.Sprint(value) == fmt.Sprint(expected)
+}
@@ -0,0 +1,51 @@
+package thirdweb
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchFilteredNFTs(t *testing.T) {
+	fetch := func(ctx context.Context, tokenId int) (*NFTMetadata, error) {
+		rarity := "common"
+		if tokenId%2 == 1 {
+			rarity = "legendary"
+		}
+
+		metadata := &NFTMetadata{Id: big.NewInt(int64(tokenId))}
+		err := json.Unmarshal([]byte(`{"attributes": [{"trait_type": "rarity", "value": "`+rarity+`"}, {"trait_type": "level", "value": 5}]}`), metadata)
+		return metadata, err
+	}
+
+	ctx := context.Background()
+	tokenIds := []int{0, 1, 2, 3, 4, 5}
+
+	nfts, err := fetchFilteredNFTs(ctx, nil, tokenIds, &NFTFilter{MaxResults: 2}, fetch)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(nfts))
+	assert.Equal(t, int64(1), nfts[1].Id.Int64())
+
+	nfts, err = fetchFilteredNFTs(ctx, nil, tokenIds, &NFTFilter{
+		HasAttribute: &AttributeFilter{TraitType: "rarity", Value: "legendary"},
+	}, fetch)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(nfts))
+	assert.Equal(t, int64(5), nfts[2].Id.Int64())
+
+	nfts, err = fetchFilteredNFTs(ctx, nil, tokenIds, &NFTFilter{
+		HasAttribute: &AttributeFilter{TraitType: "level", Value: 5},
+		MaxResults:   4,
+	}, fetch)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(nfts))
+
+	nfts, err = fetchFilteredNFTs(ctx, nil, tokenIds, &NFTFilter{
+		HasAttribute: &AttributeFilter{TraitType: "background"},
+	}, fetch)
+	assert.Nil(t, err)
+	assert.Empty(t, nfts)
+}
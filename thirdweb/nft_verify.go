@@ -0,0 +1,29 @@
+package thirdweb
+
+import (
+	"context"
+	"strings"
+)
+
+// Calls to tokens that don't exist revert, which is reported differently by each node, so only
+// reverts are treated as a missing token and any other error is returned
+func isRevertError(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), "revert")
+}
+
+// Metadata that can't be downloaded or isn't valid JSON counts as invalid rather than as an error,
+// unless the context was cancelled
+func hasValidMetadata(ctx context.Context, tokenId int, uri string, storage storage) (bool, error) {
+	if uri == "" {
+		return false, nil
+	}
+
+	if _, err := fetchTokenMetadata(ctx, tokenId, uri, storage); err != nil {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		return false, nil
+	}
+
+	return true, nil
+}
@@ -0,0 +1,55 @@
+package thirdweb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type metadataStorage struct {
+	mockStorage
+	files map[string]string
+}
+
+func (storage *metadataStorage) Get(ctx context.Context, uri string) ([]byte, error) {
+	if body, ok := storage.files[uri]; ok {
+		return []byte(body), nil
+	}
+	return nil, errors.New("not found")
+}
+
+func TestHasValidMetadata(t *testing.T) {
+	storage := &metadataStorage{files: map[string]string{
+		"ipfs://valid/0":   `{"name": "NFT"}`,
+		"ipfs://invalid/0": `not json`,
+	}}
+
+	valid, err := hasValidMetadata(context.Background(), 0, "ipfs://valid/0", storage)
+	assert.Nil(t, err)
+	assert.True(t, valid)
+
+	for _, uri := range []string{"ipfs://invalid/0", "ipfs://missing/0", ""} {
+		valid, err = hasValidMetadata(context.Background(), 0, uri, storage)
+		assert.Nil(t, err)
+		assert.False(t, valid)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = hasValidMetadata(ctx, 0, "ipfs://missing/0", storage)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestIsRevertError(t *testing.T) {
+	assert.True(t, isRevertError(errors.New("execution reverted: ERC721: owner query for nonexistent token")))
+	assert.True(t, isRevertError(errors.New("VM Exception while processing transaction: reverted with reason string")))
+	assert.False(t, isRevertError(errors.New("429 Too Many Requests")))
+}
+
+func TestVerifyOwnershipBatchRequiresMatchingQuantities(t *testing.T) {
+	erc1155 := &ERC1155{}
+	_, err := erc1155.VerifyOwnershipBatch(context.Background(), "0x71C7656EC7ab88b098defB751B7401B5f6d8976F", []int{0, 1}, []int{1})
+	assert.NotNil(t, err)
+}
@@ -5,12 +5,16 @@ import (
 	"crypto/ecdsa"
 	"errors"
 	"math/big"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
 type ProviderHandler struct {
@@ -18,11 +22,52 @@ type ProviderHandler struct {
 	privateKey    *ecdsa.PrivateKey
 	rawPrivateKey string
 	signerAddress common.Address
+	account       Account
+	broadcaster   Broadcaster
+	events        *EventEmitter
+	// Buffer applied on top of the estimated gas limit of every transaction
+	gasLimitMultiplier float64
+	// Set when the SDK dialed the RPC itself, takes precedence over provider
+	connection *rpcConnection
+	// Hashes of the transactions submitted by a relayer, keyed by the hash of the signed transaction
+	relayedTxHashes *sync.Map
+	// Number of blocks to wait for after a transaction is mined before treating it as final
+	blockConfirmations int
+	tracer             Tracer
+	metrics            Metrics
+	// Looked up once when the tracer is set, so spans don't cost an extra RPC call
+	tracedChainId string
+	// When set, transactions are run with eth_call and never broadcasted
+	simulationMode bool
+	// Transactions that were simulated instead of sent, keyed by hash
+	simulatedTxs *sync.Map
+	// Set when the SDK uses a connection pool, takes precedence over connection and provider
+	pool *rpcPool
+	// Operators checked by GetApprovedOperators
+	knownOperators []string
+	// When set, address arguments must have a valid EIP-55 checksum
+	strictAddressChecksums bool
+	// Longest wait between attempts to resubscribe to contract events
+	eventReconnectMaxBackoff time.Duration
+	// Shared by the clones of the handler, so the limit applies across all contracts
+	concurrency *concurrencyLimiter
+	// Timeout of each metadata fetch when fetching many NFTs in parallel
+	metadataFetchTimeout time.Duration
+	// Timeout of the calls shared by concurrent callers, which none of them can cancel
+	sharedRequestTimeout time.Duration
 }
 
 func NewProviderHandler(provider *ethclient.Client, privateKey string) (*ProviderHandler, error) {
 	handler := &ProviderHandler{
-		provider: provider,
+		provider:                 provider,
+		gasLimitMultiplier:       defaultGasLimitMultiplier,
+		knownOperators:           defaultKnownOperators,
+		eventReconnectMaxBackoff: wsReconnectMaxBackoff,
+		concurrency:              newConcurrencyLimiter(defaultMaxConcurrency),
+		metadataFetchTimeout:     defaultMetadataFetchTimeout,
+		sharedRequestTimeout:     defaultSharedRequestTimeout,
+		relayedTxHashes:          &sync.Map{},
+		simulatedTxs:             &sync.Map{},
 	}
 
 	if privateKey != "" {
@@ -36,6 +81,8 @@ func NewProviderHandler(provider *ethclient.Client, privateKey string) (*Provide
 
 func (handler *ProviderHandler) UpdateProvider(provider *ethclient.Client) {
 	handler.provider = provider
+	handler.connection = nil
+	handler.pool = nil
 }
 
 func (handler *ProviderHandler) UpdatePrivateKey(privateKey string) error {
@@ -46,10 +93,123 @@ func (handler *ProviderHandler) UpdatePrivateKey(privateKey string) error {
 	}
 }
 
+// Sign transactions with a custom account instead of a private key. Note that features which
+// sign messages, like signature minting and wallet authentication, still require a private key,
+// which is only available for accounts loaded with LoadKeystoreFile.
+func (handler *ProviderHandler) UpdateAccount(account Account) {
+	handler.account = account
+	handler.signerAddress = account.Address()
+	handler.privateKey = nil
+	handler.rawPrivateKey = ""
+
+	if signer, ok := account.(*LocalSigner); ok {
+		handler.privateKey = signer.privateKey
+	}
+}
+
+// Send signed transactions with a custom broadcaster instead of the RPC provider.
+func (handler *ProviderHandler) UpdateBroadcaster(broadcaster Broadcaster) {
+	handler.broadcaster = broadcaster
+}
+
+// Run write transactions with eth_call instead of broadcasting them. Write methods return the
+// unsent transaction as if it had been mined, or the revert error if it would have failed.
+// SmartContract.Call returns a SimulationResult with the decoded return values instead.
+func (handler *ProviderHandler) UpdateSimulationMode(enabled bool) {
+	handler.simulationMode = enabled
+}
+
+// Require address arguments to have a valid EIP-55 checksum. Addresses without one, including all
+// lowercase addresses, are rejected with ErrInvalidAddress.
+func (handler *ProviderHandler) UpdateStrictAddressChecksums(enabled bool) {
+	handler.strictAddressChecksums = enabled
+}
+
+// Limit the number of goroutines run at once when fetching many tokens in parallel, like when
+// listing all the NFTs of a collection. The limit applies across all the contracts of the SDK and
+// defaults to 50, a limit of zero or less removes it.
+func (handler *ProviderHandler) SetMaxConcurrency(n int) {
+	handler.concurrency.setLimit(n)
+}
+
+// Record metrics for the RPC calls and transactions made through the contracts.
+func (handler *ProviderHandler) UpdateMetrics(metrics Metrics) {
+	handler.metrics = metrics
+}
+
+// Trace the eth_call and eth_sendRawTransaction requests made through the contracts.
+func (handler *ProviderHandler) UpdateTracer(ctx context.Context, tracer Tracer) error {
+	chainId, err := handler.GetChainID(ctx)
+	if err != nil {
+		return err
+	}
+
+	handler.tracer = tracer
+	handler.tracedChainId = chainId.String()
+	return nil
+}
+
 func (handler *ProviderHandler) GetProvider() *ethclient.Client {
+	if handler.pool != nil {
+		return handler.pool.getProvider()
+	}
+	if handler.connection != nil {
+		return handler.connection.getProvider()
+	}
 	return handler.provider
 }
 
+// Returns the raw client behind the provider, for the RPC methods ethclient doesn't wrap. It's nil
+// when the SDK was created from a provider, since ethclient doesn't expose its client.
+func (handler *ProviderHandler) getRpcClient() *rpc.Client {
+	if handler.pool != nil {
+		return handler.pool.getRpcClient()
+	}
+	if handler.connection != nil {
+		return handler.connection.getRpcClient()
+	}
+	return nil
+}
+
+type feeHistory struct {
+	// Base fee of each block, followed by the base fee of the next block
+	BaseFee []*big.Int
+	// Priority fees paid in each block at the requested percentiles
+	Reward [][]*big.Int
+}
+
+// Calls eth_feeHistory for the latest blocks, which our version of ethclient doesn't support
+func (handler *ProviderHandler) getFeeHistory(ctx context.Context, blockCount uint64, rewardPercentiles []float64) (*feeHistory, error) {
+	rpcClient := handler.getRpcClient()
+	if rpcClient == nil {
+		return nil, errors.New("eth_feeHistory requires an SDK created from an RPC URL")
+	}
+
+	var result struct {
+		BaseFee []*hexutil.Big   `json:"baseFeePerGas"`
+		Reward  [][]*hexutil.Big `json:"reward"`
+	}
+	if err := rpcClient.CallContext(ctx, &result, "eth_feeHistory", hexutil.Uint64(blockCount), "latest", rewardPercentiles); err != nil {
+		return nil, err
+	}
+
+	history := &feeHistory{
+		BaseFee: make([]*big.Int, len(result.BaseFee)),
+		Reward:  make([][]*big.Int, len(result.Reward)),
+	}
+	for i, baseFee := range result.BaseFee {
+		history.BaseFee[i] = (*big.Int)(baseFee)
+	}
+	for i, rewards := range result.Reward {
+		history.Reward[i] = make([]*big.Int, len(rewards))
+		for j, reward := range rewards {
+			history.Reward[i][j] = (*big.Int)(reward)
+		}
+	}
+
+	return history, nil
+}
+
 func (handler *ProviderHandler) GetSignerAddress() common.Address {
 	return handler.signerAddress
 }
@@ -63,7 +223,33 @@ func (handler *ProviderHandler) GetPrivateKey() *ecdsa.PrivateKey {
 }
 
 func (handler *ProviderHandler) GetChainID(ctx context.Context) (*big.Int, error) {
-	return handler.provider.ChainID(ctx)
+	return handler.GetProvider().ChainID(ctx)
+}
+
+// Each module gets its own copy of the handler so that updating the signer on one module
+// doesn't affect the others, while still sharing the SDK-wide event emitter
+func (handler *ProviderHandler) clone() *ProviderHandler {
+	copied := *handler
+	return &copied
+}
+
+func (handler *ProviderHandler) getBroadcaster() Broadcaster {
+	if handler.broadcaster == nil {
+		return &providerBroadcaster{handler}
+	}
+	return handler.broadcaster
+}
+
+// Returns the hash of the transaction that actually landed on-chain for a signed transaction
+func (handler *ProviderHandler) getSentTxHash(hash common.Hash) common.Hash {
+	if relayed, ok := handler.relayedTxHashes.Load(hash); ok {
+		return relayed.(common.Hash)
+	}
+	return hash
+}
+
+func (handler *ProviderHandler) getBackend() bind.ContractBackend {
+	return &handlerBackend{handler}
 }
 
 func (handler *ProviderHandler) getSigner(ctx context.Context) (bind.SignerFn, error) {
@@ -71,8 +257,9 @@ func (handler *ProviderHandler) getSigner(ctx context.Context) (bind.SignerFn, e
 	if err != nil {
 		return nil, err
 	}
+	account := handler.account
 	return func(address common.Address, transaction *types.Transaction) (*types.Transaction, error) {
-		return types.SignTx(transaction, types.LatestSignerForChainID(chainId), handler.privateKey)
+		return account.Sign(transaction, chainId)
 	}, nil
 }
 
@@ -83,6 +270,7 @@ func (handler *ProviderHandler) updateAccount(privateKey string) error {
 		handler.privateKey = key
 		handler.signerAddress = publicAddress
 		handler.rawPrivateKey = privateKey
+		handler.account = newPrivateKeyAccount(key, publicAddress)
 		return nil
 	}
 }
@@ -114,3 +302,15 @@ func processPrivateKey(privateKey string) (*ecdsa.PrivateKey, common.Address, er
 
 	return key, publicAddress, nil
 }
+
+func (handler *ProviderHandler) isValidAddress(address string) bool {
+	return isValidAddress(address, handler.strictAddressChecksums)
+}
+
+// In strict mode the address must be written exactly as its EIP-55 checksummed form
+func isValidAddress(address string, strictChecksum bool) bool {
+	if !common.IsHexAddress(address) {
+		return false
+	}
+	return !strictChecksum || common.HexToAddress(address).Hex() == address
+}
@@ -0,0 +1,209 @@
+package thirdweb
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	wsReconnectInitialBackoff = time.Second * 1
+	wsReconnectMaxBackoff     = time.Second * 60
+)
+
+// The connection is shared by the SDK and every module it creates, so that reconnecting a
+// dropped WebSocket swaps the provider out for all of them at once
+type rpcConnection struct {
+	mu        sync.RWMutex
+	url       string
+	rpcClient *rpc.Client
+	provider  *ethclient.Client
+	connected bool
+	// Closed to stop the watch goroutine
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func dialRpcConnection(ctx context.Context, url string) (*rpcConnection, error) {
+	rpcClient, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &rpcConnection{
+		url:       url,
+		rpcClient: rpcClient,
+		provider:  ethclient.NewClient(rpcClient),
+		connected: true,
+		done:      make(chan struct{}),
+	}
+
+	if conn.isWebSocket() {
+		go conn.watch()
+	}
+
+	return conn, nil
+}
+
+func (conn *rpcConnection) isWebSocket() bool {
+	return strings.HasPrefix(conn.url, "ws://") || strings.HasPrefix(conn.url, "wss://")
+}
+
+func (conn *rpcConnection) getProvider() *ethclient.Client {
+	conn.mu.RLock()
+	defer conn.mu.RUnlock()
+
+	return conn.provider
+}
+
+func (conn *rpcConnection) getRpcClient() *rpc.Client {
+	conn.mu.RLock()
+	defer conn.mu.RUnlock()
+
+	return conn.rpcClient
+}
+
+func (conn *rpcConnection) isConnected() bool {
+	conn.mu.RLock()
+	defer conn.mu.RUnlock()
+
+	return conn.connected
+}
+
+// Keeps a subscription to new block headers open as a heartbeat, since the RPC client doesn't
+// tell us when the underlying WebSocket drops, and reconnects whenever it errors out
+func (conn *rpcConnection) watch() {
+	backoff := wsReconnectInitialBackoff
+	for {
+		heads := make(chan *types.Header)
+		sub, err := conn.getRpcClient().EthSubscribe(context.Background(), heads, "newHeads")
+		if err == nil {
+			backoff = wsReconnectInitialBackoff
+			err = conn.waitForSubscriptionError(sub, heads)
+			if err == nil {
+				return
+			}
+		}
+
+		// The node answered, so the connection is fine and only the subscription was rejected.
+		// Redialing would just drop the subscriptions of the SDK for nothing.
+		if _, ok := err.(rpc.Error); ok {
+			log.Printf("Failed to subscribe to new blocks, retrying in %v, err = %v\n", backoff, err)
+		} else {
+			log.Printf("WebSocket connection to RPC lost, err = %v\n", err)
+			conn.setConnected(false)
+			if !conn.reconnect() {
+				return
+			}
+		}
+
+		// Subscribing can fail right away, so we back off here too rather than spin
+		if !conn.sleep(backoff) {
+			return
+		}
+		backoff = nextReconnectBackoff(backoff)
+	}
+}
+
+// Returns the error that ended the subscription, or nil if the connection was closed
+func (conn *rpcConnection) waitForSubscriptionError(sub *rpc.ClientSubscription, heads chan *types.Header) error {
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-heads:
+			// Only the errors matter, but we keep reading so the client doesn't buffer the headers
+		case err := <-sub.Err():
+			return err
+		case <-conn.done:
+			return nil
+		}
+	}
+}
+
+// Returns false if the connection was closed before it could be restored
+func (conn *rpcConnection) reconnect() bool {
+	backoff := wsReconnectInitialBackoff
+	for {
+		if conn.isClosed() {
+			return false
+		}
+
+		rpcClient, err := rpc.DialContext(context.Background(), conn.url)
+		if err == nil {
+			conn.mu.Lock()
+			if conn.isClosed() {
+				conn.mu.Unlock()
+				rpcClient.Close()
+				return false
+			}
+			conn.rpcClient.Close()
+			conn.rpcClient = rpcClient
+			conn.provider = ethclient.NewClient(rpcClient)
+			conn.connected = true
+			conn.mu.Unlock()
+
+			log.Println("WebSocket connection to RPC restored")
+			return true
+		}
+
+		log.Printf("Failed to reconnect to RPC, retrying in %v, err = %v\n", backoff, err)
+		if !conn.sleep(backoff) {
+			return false
+		}
+		backoff = nextReconnectBackoff(backoff)
+	}
+}
+
+// Returns false if the connection was closed while sleeping
+func (conn *rpcConnection) sleep(duration time.Duration) bool {
+	select {
+	case <-time.After(duration):
+		return true
+	case <-conn.done:
+		return false
+	}
+}
+
+func nextReconnectBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > wsReconnectMaxBackoff {
+		return wsReconnectMaxBackoff
+	}
+	return backoff
+}
+
+func (conn *rpcConnection) isClosed() bool {
+	select {
+	case <-conn.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Stops reconnecting and closes the client
+func (conn *rpcConnection) close() {
+	conn.closeOnce.Do(func() {
+		close(conn.done)
+
+		conn.mu.Lock()
+		defer conn.mu.Unlock()
+
+		conn.rpcClient.Close()
+		conn.connected = false
+	})
+}
+
+func (conn *rpcConnection) setConnected(connected bool) {
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+
+	conn.connected = connected
+}
@@ -0,0 +1,55 @@
+package thirdweb
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRpcConnectionDoesNotRedialWhenSubscribeIsRejected(t *testing.T) {
+	// The server has no eth namespace, so it rejects the newHeads subscription with an RPC error
+	server := rpc.NewServer()
+	defer server.Stop()
+
+	var dials int32
+	wsHandler := server.WebsocketHandler([]string{"*"})
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&dials, 1)
+		wsHandler.ServeHTTP(w, r)
+	}))
+	defer httpServer.Close()
+
+	conn, err := dialRpcConnection(context.Background(), "ws"+strings.TrimPrefix(httpServer.URL, "http"))
+	assert.Nil(t, err)
+
+	time.Sleep(200 * time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&dials))
+	assert.True(t, conn.isConnected())
+
+	conn.close()
+	assert.False(t, conn.isConnected())
+	assert.True(t, conn.isClosed())
+}
+
+func TestGetDefaultRpcUrlAcceptsWebSocketUrls(t *testing.T) {
+	for _, url := range []string{
+		"http://localhost:8545",
+		"https://polygon-rpc.com",
+		"ws://localhost:8546",
+		"wss://polygon-mainnet.g.alchemy.com/v2/key",
+	} {
+		rpcUrl, err := getDefaultRpcUrl(url)
+		assert.Nil(t, err)
+		assert.Equal(t, url, rpcUrl)
+	}
+
+	_, err := getDefaultRpcUrl("localhost:8545")
+	assert.NotNil(t, err)
+}
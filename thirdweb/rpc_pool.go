@@ -0,0 +1,125 @@
+package thirdweb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// How long a node that returned an error is skipped for before it gets requests again
+const rpcPoolFailureCooldown = time.Second * 30
+
+// A round-robin pool of RPC clients, so concurrent calls are spread over several connections and
+// nodes. HTTP nodes that fail are skipped until their cooldown is over, unless every node is failing.
+type rpcPool struct {
+	mu    sync.Mutex
+	nodes []*rpcPoolNode
+	next  int
+}
+
+type rpcPoolNode struct {
+	url       string
+	rpcClient *rpc.Client
+	provider  *ethclient.Client
+	failedAt  time.Time
+}
+
+// Dials size clients, spread evenly over the URLs
+func dialRpcPool(ctx context.Context, urls []string, size int, httpClient *http.Client) (*rpcPool, error) {
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("Connection pool requires at least one RPC URL")
+	}
+	if size < len(urls) {
+		size = len(urls)
+	}
+
+	pool := &rpcPool{}
+	for i := 0; i < size; i++ {
+		node := &rpcPoolNode{url: urls[i%len(urls)]}
+
+		var rpcClient *rpc.Client
+		var err error
+		if strings.HasPrefix(node.url, "http") {
+			// Failures are detected at the transport so every call through the client is covered
+			transport := httpClient.Transport
+			if transport == nil {
+				transport = http.DefaultTransport
+			}
+			rpcClient, err = rpc.DialHTTPWithClient(node.url, &http.Client{
+				Transport: &rpcPoolTransport{pool: pool, node: node, next: transport},
+				Timeout:   httpClient.Timeout,
+			})
+		} else {
+			rpcClient, err = rpc.DialContext(ctx, node.url)
+		}
+		if err != nil {
+			pool.close()
+			return nil, err
+		}
+
+		node.rpcClient = rpcClient
+		node.provider = ethclient.NewClient(rpcClient)
+		pool.nodes = append(pool.nodes, node)
+	}
+
+	return pool, nil
+}
+
+func (pool *rpcPool) getProvider() *ethclient.Client {
+	return pool.nextNode().provider
+}
+
+func (pool *rpcPool) getRpcClient() *rpc.Client {
+	return pool.nextNode().rpcClient
+}
+
+func (pool *rpcPool) nextNode() *rpcPoolNode {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	for i := 0; i < len(pool.nodes); i++ {
+		node := pool.nodes[(pool.next+i)%len(pool.nodes)]
+		if time.Since(node.failedAt) >= rpcPoolFailureCooldown {
+			pool.next = (pool.next + i + 1) % len(pool.nodes)
+			return node
+		}
+	}
+
+	// Every node is failing, so keep rotating rather than giving up on all of them
+	node := pool.nodes[pool.next]
+	pool.next = (pool.next + 1) % len(pool.nodes)
+	return node
+}
+
+func (pool *rpcPool) markFailed(node *rpcPoolNode) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	node.failedAt = time.Now()
+}
+
+func (pool *rpcPool) close() {
+	for _, node := range pool.nodes {
+		node.provider.Close()
+	}
+}
+
+type rpcPoolTransport struct {
+	pool *rpcPool
+	node *rpcPoolNode
+	next http.RoundTripper
+}
+
+func (transport *rpcPoolTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := transport.next.RoundTrip(req)
+	if err != nil || res.StatusCode >= http.StatusInternalServerError || res.StatusCode == http.StatusTooManyRequests {
+		transport.pool.markFailed(transport.node)
+	}
+	return res, err
+}
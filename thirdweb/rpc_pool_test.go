@@ -0,0 +1,40 @@
+package thirdweb
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRpcPoolSkipsFailingNodes(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+
+	var healthyCalls int32
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&healthyCalls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"jsonrpc": "2.0", "id": 1, "result": "0x89"}`))
+	}))
+	defer healthy.Close()
+
+	pool, err := dialRpcPool(context.Background(), []string{failing.URL, healthy.URL}, 2, http.DefaultClient)
+	assert.Nil(t, err)
+	defer pool.close()
+
+	_, err = pool.getProvider().ChainID(context.Background())
+	assert.NotNil(t, err)
+
+	for i := 0; i < 3; i++ {
+		chainId, err := pool.getProvider().ChainID(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, int64(137), chainId.Int64())
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(&healthyCalls))
+}
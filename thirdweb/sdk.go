@@ -3,46 +3,90 @@ package thirdweb
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/ethereum/go-ethereum"
+	gethAbi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/thirdweb-dev/go-sdk/v2/abi"
 )
 
 type ThirdwebSDK struct {
 	*ProviderHandler
-	Storage  IpfsStorage
-	Deployer ContractDeployer
-	Auth     WalletAuthenticator
+	Storage   IpfsStorage
+	Deployer  ContractDeployer
+	Publisher ContractPublisher
+	Auth      WalletAuthenticator
+	events    *EventEmitter
+	alchemy   *alchemyClient
+	// Used by the API clients that can be enabled after the SDK is created
+	httpClient *http.Client
+	etherscan  *etherscanClient
+	engine     *engineClient
+	// Set when the SmartWallet SDK option is used
+	SmartWallet *SmartWallet
 }
 
 // NewThirdwebSDK
 //
 // # Create a new instance of the Thirdweb SDK
 //
-// rpcUrlOrName: the name of the chain to connection to (e.g. "rinkeby", "mumbai", "polygon", "mainnet", "fantom", "avalanche") or the RPC URL to connect to
+// rpcUrlOrName: the name of the chain to connection to (e.g. "rinkeby", "mumbai", "polygon", "mainnet", "fantom", "avalanche") or the RPC URL to connect to, which can be an HTTP or WebSocket URL
 //
 // options: an SDKOptions instance to specify a private key and/or an IPFS gateway URL
 func NewThirdwebSDK(rpcUrlOrChainName string, options *SDKOptions) (*ThirdwebSDK, error) {
-	rpc, err := getDefaultRpcUrl(rpcUrlOrChainName)
+	rpcUrl, err := getDefaultRpcUrl(rpcUrlOrChainName)
 	if err != nil {
 		return nil, err
 	}
 
-	provider, err := ethclient.Dial(rpc)
+	// WebSocket URLs are supported as well, and get reconnected automatically if they drop
+	connection, err := dialRpcConnection(context.Background(), rpcUrl)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewThirdwebSDKFromProvider(provider, options)
+	return newThirdwebSDK(connection.getProvider(), connection, options)
 }
 
 func NewThirdwebSDKFromProvider(provider *ethclient.Client, options *SDKOptions) (*ThirdwebSDK, error) {
+	return newThirdwebSDK(provider, nil, options)
+}
+
+func newThirdwebSDK(provider *ethclient.Client, connection *rpcConnection, options *SDKOptions) (*ThirdwebSDK, error) {
 	// Define defaults for all the options
 	privateKey := ""
 	gatewayUrl := defaultIpfsGatewayUrl
+	fallbackGatewayUrls := []string{}
 	httpClient := http.DefaultClient
+	gasLimitMultiplier := defaultGasLimitMultiplier
+	blockConfirmations := 0
+	simulationMode := false
+	alchemyApiKey := ""
+	etherscanApiKey := ""
+	engineUrl := ""
+	engineAccessToken := ""
+	engineBackendWallet := ""
+	var gasless *GaslessOptions
+	var tracer Tracer
+	var metrics Metrics
+	var smartWalletOptions *SmartWalletOptions
+	var connectionPool *ConnectionPoolOptions
+	knownOperators := defaultKnownOperators
+	strictAddressChecksums := false
+	eventReconnectMaxBackoff := wsReconnectMaxBackoff
+	metadataFetchTimeout := defaultMetadataFetchTimeout
+	sharedRequestTimeout := defaultSharedRequestTimeout
 
 	// Override defaults with the options that are defined
 	if options != nil {
@@ -54,24 +98,169 @@ func NewThirdwebSDKFromProvider(provider *ethclient.Client, options *SDKOptions)
 			gatewayUrl = options.GatewayUrl
 		}
 
+		if len(options.FallbackGatewayUrls) > 0 {
+			fallbackGatewayUrls = options.FallbackGatewayUrls
+		}
+
 		if options.HttpClient != nil {
 			httpClient = options.HttpClient
 		}
+
+		if options.GasLimitMultiplier > 0 {
+			gasLimitMultiplier = options.GasLimitMultiplier
+		}
+
+		if options.BlockConfirmations > 0 {
+			blockConfirmations = options.BlockConfirmations
+		}
+
+		if options.SimulationMode {
+			simulationMode = true
+		}
+
+		if options.AlchemyApiKey != "" {
+			alchemyApiKey = options.AlchemyApiKey
+		}
+
+		if options.EtherscanApiKey != "" {
+			etherscanApiKey = options.EtherscanApiKey
+		}
+
+		if options.EngineUrl != "" {
+			engineUrl = options.EngineUrl
+			engineAccessToken = options.EngineAccessToken
+			engineBackendWallet = options.EngineBackendWallet
+		}
+
+		if options.Gasless != nil {
+			gasless = options.Gasless
+		}
+
+		if options.Tracer != nil {
+			tracer = options.Tracer
+		}
+
+		if options.Metrics != nil {
+			metrics = options.Metrics
+		}
+
+		if options.SmartWallet != nil {
+			smartWalletOptions = options.SmartWallet
+		}
+
+		if options.ConnectionPool != nil {
+			connectionPool = options.ConnectionPool
+		}
+
+		if len(options.KnownOperators) > 0 {
+			knownOperators = options.KnownOperators
+		}
+
+		if options.StrictAddressChecksums {
+			strictAddressChecksums = true
+		}
+
+		if options.EventReconnectMaxBackoff > 0 {
+			eventReconnectMaxBackoff = options.EventReconnectMaxBackoff
+		}
+
+		if options.MetadataFetchTimeout > 0 {
+			metadataFetchTimeout = options.MetadataFetchTimeout
+		}
+
+		if options.SharedRequestTimeout > 0 {
+			sharedRequestTimeout = options.SharedRequestTimeout
+		}
 	}
 
+	events := newEventEmitter()
+
 	storage := newIpfsStorage(gatewayUrl, httpClient)
+	storage.fallbackGatewayUrls = fallbackGatewayUrls
+	storage.events = events
+	storage.metrics = metrics
 
 	handler, err := NewProviderHandler(provider, privateKey)
 	if err != nil {
 		return nil, err
 	}
+	handler.events = events
+	handler.gasLimitMultiplier = gasLimitMultiplier
+	handler.blockConfirmations = blockConfirmations
+	handler.simulationMode = simulationMode
+	handler.connection = connection
+	handler.metrics = metrics
+	handler.knownOperators = knownOperators
+	handler.strictAddressChecksums = strictAddressChecksums
+	handler.eventReconnectMaxBackoff = eventReconnectMaxBackoff
+	handler.metadataFetchTimeout = metadataFetchTimeout
+	handler.sharedRequestTimeout = sharedRequestTimeout
+
+	if connectionPool != nil {
+		urls := connectionPool.Urls
+		if len(urls) == 0 && connection != nil {
+			urls = []string{connection.url}
+		}
+
+		pool, err := dialRpcPool(context.Background(), urls, connectionPool.Size, httpClient)
+		if err != nil {
+			return nil, err
+		}
+		handler.pool = pool
+	}
+
+	if tracer != nil {
+		if err := handler.UpdateTracer(context.Background(), tracer); err != nil {
+			return nil, err
+		}
+	}
+
+	if gasless != nil && gasless.Biconomy != nil {
+		deadlineSeconds := defaultBiconomyDeadlineSeconds
+		if gasless.Biconomy.DeadlineSeconds > 0 {
+			deadlineSeconds = gasless.Biconomy.DeadlineSeconds
+		}
+		handler.UpdateBroadcaster(newBiconomyBroadcaster(
+			handler,
+			gasless.Biconomy.ApiKey,
+			gasless.Biconomy.ApiId,
+			deadlineSeconds,
+			httpClient,
+		))
+	} else if gasless != nil && gasless.OpenGSN != nil {
+		handler.UpdateBroadcaster(newGSNBroadcaster(handler, gasless.OpenGSN, httpClient))
+	} else if gasless != nil && gasless.OpenZeppelin != nil {
+		handler.UpdateBroadcaster(newDefenderBroadcaster(handler, gasless.OpenZeppelin, httpClient))
+	}
+
+	var engine *engineClient
+	if engineUrl != "" {
+		engine = newEngineClient(engineUrl, engineAccessToken, engineBackendWallet, httpClient)
+		if gasless == nil {
+			handler.UpdateBroadcaster(newEngineBroadcaster(handler, engine))
+		}
+	}
+
+	var smartWallet *SmartWallet
+	if smartWalletOptions != nil {
+		smartWallet, err = newSmartWallet(handler, smartWalletOptions)
+		if err != nil {
+			return nil, err
+		}
+		handler.UpdateBroadcaster(smartWallet)
+	}
+
+	deployer, err := newContractDeployer(handler, storage)
+	if err != nil {
+		return nil, err
+	}
 
-	deployer, err := newContractDeployer(provider, privateKey, storage)
+	publisher, err := newContractPublisher(handler, storage)
 	if err != nil {
 		return nil, err
 	}
 
-	auth, err := newWalletAuthenticator(provider, privateKey)
+	auth, err := newWalletAuthenticator(handler)
 	if err != nil {
 		return nil, err
 	}
@@ -80,7 +269,17 @@ func NewThirdwebSDKFromProvider(provider *ethclient.Client, options *SDKOptions)
 		ProviderHandler: handler,
 		Storage:         *storage,
 		Deployer:        *deployer,
+		Publisher:       *publisher,
 		Auth:            *auth,
+		events:          events,
+		etherscan:       newEtherscanClient(etherscanApiKey, httpClient),
+		httpClient:      httpClient,
+		engine:          engine,
+		SmartWallet:     smartWallet,
+	}
+
+	if alchemyApiKey != "" {
+		sdk.alchemy = newAlchemyClient(alchemyApiKey, httpClient)
 	}
 
 	return sdk, nil
@@ -93,9 +292,8 @@ func NewThirdwebSDKFromProvider(provider *ethclient.Client, options *SDKOptions)
 // address: the address of the NFT Collection contract
 func (sdk *ThirdwebSDK) GetNFTCollection(address string) (*NFTCollection, error) {
 	return newNFTCollection(
-		sdk.GetProvider(),
+		sdk.ProviderHandler,
 		common.HexToAddress(address),
-		sdk.GetRawPrivateKey(),
 		&sdk.Storage,
 	)
 }
@@ -107,9 +305,8 @@ func (sdk *ThirdwebSDK) GetNFTCollection(address string) (*NFTCollection, error)
 // address: the address of the Edition contract
 func (sdk *ThirdwebSDK) GetEdition(address string) (*Edition, error) {
 	return newEdition(
-		sdk.GetProvider(),
+		sdk.ProviderHandler,
 		common.HexToAddress(address),
-		sdk.GetRawPrivateKey(),
 		&sdk.Storage,
 	)
 }
@@ -123,9 +320,8 @@ func (sdk *ThirdwebSDK) GetEdition(address string) (*Edition, error) {
 // Returns a Token contract SDK instance
 func (sdk *ThirdwebSDK) GetToken(address string) (*Token, error) {
 	return newToken(
-		sdk.GetProvider(),
+		sdk.ProviderHandler,
 		common.HexToAddress(address),
-		sdk.GetRawPrivateKey(),
 		&sdk.Storage,
 	)
 }
@@ -137,9 +333,8 @@ func (sdk *ThirdwebSDK) GetToken(address string) (*Token, error) {
 // address: the address of the NFT Drop contract
 func (sdk *ThirdwebSDK) GetNFTDrop(address string) (*NFTDrop, error) {
 	return newNFTDrop(
-		sdk.GetProvider(),
+		sdk.ProviderHandler,
 		common.HexToAddress(address),
-		sdk.GetRawPrivateKey(),
 		&sdk.Storage,
 	)
 }
@@ -151,9 +346,8 @@ func (sdk *ThirdwebSDK) GetNFTDrop(address string) (*NFTDrop, error) {
 // address: the address of the Edition Drop contract
 func (sdk *ThirdwebSDK) GetEditionDrop(address string) (*EditionDrop, error) {
 	return newEditionDrop(
-		sdk.GetProvider(),
+		sdk.ProviderHandler,
 		common.HexToAddress(address),
-		sdk.GetRawPrivateKey(),
 		&sdk.Storage,
 	)
 }
@@ -165,9 +359,8 @@ func (sdk *ThirdwebSDK) GetEditionDrop(address string) (*EditionDrop, error) {
 // address: the address of the Multiwrap contract
 func (sdk *ThirdwebSDK) GetMultiwrap(address string) (*Multiwrap, error) {
 	return newMultiwrap(
-		sdk.GetProvider(),
+		sdk.ProviderHandler,
 		common.HexToAddress(address),
-		sdk.GetRawPrivateKey(),
 		&sdk.Storage,
 	)
 }
@@ -179,9 +372,8 @@ func (sdk *ThirdwebSDK) GetMultiwrap(address string) (*Multiwrap, error) {
 // address: the address of the Marketplace contract
 func (sdk *ThirdwebSDK) GetMarketplace(address string) (*Marketplace, error) {
 	return newMarketplace(
-		sdk.GetProvider(),
+		sdk.ProviderHandler,
 		common.HexToAddress(address),
-		sdk.GetRawPrivateKey(),
 		&sdk.Storage,
 	)
 }
@@ -190,9 +382,21 @@ func (sdk *ThirdwebSDK) GetMarketplace(address string) (*Marketplace, error) {
 //
 // # Get an instance of a custom contract deployed with thirdweb deploy
 //
+// If the contract wasn't deployed with thirdweb and an EtherscanApiKey is set in the SDK options,
+// the verified ABI from Etherscan is used instead.
+//
 // address: the address of the contract
 func (sdk *ThirdwebSDK) GetContract(ctx context.Context, address string) (*SmartContract, error) {
 	abi, err := fetchContractMetadataFromAddress(ctx, address, sdk.GetProvider(), &sdk.Storage)
+	if err != nil && sdk.etherscan.apiKey != "" {
+		// Etherscan only knows the ABI of the proxy itself, so ask for the implementation's instead
+		abiAddress := address
+		if implementationAddress, _ := fetchEIP1967ImplementationAddress(ctx, address, sdk.GetProvider()); implementationAddress != "" {
+			abiAddress = implementationAddress
+		}
+
+		abi, err = sdk.GetVerifiedContractABI(ctx, abiAddress, "")
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -200,6 +404,266 @@ func (sdk *ThirdwebSDK) GetContract(ctx context.Context, address string) (*Smart
 	return sdk.GetContractFromAbi(address, abi)
 }
 
+// GetVerifiedContractABI
+//
+// # Get the ABI of a contract verified on Etherscan
+//
+// address: the address of the contract
+//
+// etherscanApiKey: the Etherscan API key to use, defaults to the EtherscanApiKey in the SDK options if empty
+//
+// returns: the ABI of the contract
+//
+// Example
+//
+//	abi, err := sdk.GetVerifiedContractABI(context.Background(), "{{contract_address}}", "{{etherscan_api_key}}")
+//	contract, err := sdk.GetContractFromAbi("{{contract_address}}", abi)
+func (sdk *ThirdwebSDK) GetVerifiedContractABI(ctx context.Context, address string, etherscanApiKey string) (string, error) {
+	if !sdk.isValidAddress(address) {
+		return "", ErrInvalidAddress
+	}
+
+	chainId, err := sdk.GetChainID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return sdk.etherscan.getContractAbi(ctx, ChainID(chainId.Int64()), address, etherscanApiKey)
+}
+
+// GetContractType
+//
+// # Get the type of the contract deployed at a given address
+//
+// address: the address of the contract
+//
+// returns: the type of the contract, which is one of the prebuilt contract types if the contract
+// was deployed with thirdweb, otherwise the token standard it implements or ContractTypeCustom
+//
+// Example
+//
+//	contractType, err := sdk.GetContractType(context.Background(), "{{contract_address}}")
+//	if contractType == thirdweb.ContractTypeNFTCollection {
+//		contract, err := sdk.GetNFTCollection("{{contract_address}}")
+//	}
+func (sdk *ThirdwebSDK) GetContractType(ctx context.Context, address string) (ContractType, error) {
+	return fetchContractType(ctx, address, sdk.GetProvider())
+}
+
+// GetContractDeploymentTransaction
+//
+// # Get when and by whom a contract was deployed
+//
+// This binary searches the chain for the block the contract's code first appeared in, so it needs
+// an RPC that serves historical state, like an archive node.
+//
+// address: the address of the contract
+//
+// returns: the block and timestamp of the deployment, along with the deployer and the transaction
+// if the deployment transaction could be identified
+//
+// Example
+//
+//	deployment, err := sdk.GetContractDeploymentTransaction(context.Background(), "{{contract_address}}")
+//	fmt.Println(deployment.BlockNumber, deployment.Timestamp, deployment.DeployerAddress)
+func (sdk *ThirdwebSDK) GetContractDeploymentTransaction(ctx context.Context, address string) (*DeploymentInfo, error) {
+	if !sdk.isValidAddress(address) {
+		return nil, ErrInvalidAddress
+	}
+
+	return fetchContractDeployment(ctx, address, sdk.GetProvider())
+}
+
+// GetProxyImplementation
+//
+// # Get the implementation address of an upgradeable EIP-1967 proxy contract
+//
+// proxyAddress: the address of the proxy contract
+//
+// returns: the address of the implementation contract the proxy delegates to
+//
+// Example
+//
+//	implementation, err := sdk.GetProxyImplementation(context.Background(), "{{contract_address}}")
+func (sdk *ThirdwebSDK) GetProxyImplementation(ctx context.Context, proxyAddress string) (string, error) {
+	if !sdk.isValidAddress(proxyAddress) {
+		return "", ErrInvalidAddress
+	}
+
+	implementationAddress, err := fetchEIP1967ImplementationAddress(ctx, proxyAddress, sdk.GetProvider())
+	if err != nil {
+		return "", err
+	}
+	if implementationAddress == "" {
+		return "", fmt.Errorf("Contract at '%s' is not an EIP-1967 proxy", proxyAddress)
+	}
+
+	return implementationAddress, nil
+}
+
+const proxyUpgradeAbi = `[{
+	"type": "function",
+	"name": "upgradeTo",
+	"stateMutability": "nonpayable",
+	"inputs": [{"name": "newImplementation", "type": "address"}],
+	"outputs": []
+}]`
+
+// UpgradeProxy
+//
+// # Upgrade an EIP-1967 proxy contract to a new implementation, which requires the connected wallet to be the proxy admin
+//
+// proxyAddress: the address of the proxy contract
+//
+// newImplementation: the address of the new implementation contract
+//
+// returns: the transaction receipt of the upgrade
+//
+// Example
+//
+//	tx, err := sdk.UpgradeProxy(context.Background(), "{{contract_address}}", "0x...")
+func (sdk *ThirdwebSDK) UpgradeProxy(ctx context.Context, proxyAddress string, newImplementation string, options ...*TransactionOptions) (*types.Transaction, error) {
+	if !sdk.isValidAddress(proxyAddress) || !sdk.isValidAddress(newImplementation) {
+		return nil, ErrInvalidAddress
+	}
+
+	parsedAbi, err := gethAbi.JSON(strings.NewReader(proxyUpgradeAbi))
+	if err != nil {
+		return nil, err
+	}
+
+	helper, err := newContractHelper(common.HexToAddress(proxyAddress), sdk.ProviderHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	txOpts, err := helper.GetTxOptions(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := sdk.getBackend()
+	proxy := bind.NewBoundContract(common.HexToAddress(proxyAddress), parsedAbi, backend, backend, backend)
+	tx, err := proxy.Transact(txOpts, "upgradeTo", common.HexToAddress(newImplementation))
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.AwaitTx(ctx, tx.Hash())
+}
+
+// GetProxyAdmin
+//
+// # Get the admin address of an upgradeable EIP-1967 proxy contract
+//
+// Only the admin can upgrade a transparent proxy, so check this before calling UpgradeProxy. UUPS
+// proxies are upgraded through the implementation and usually have no admin set.
+//
+// proxyAddress: the address of the proxy contract
+//
+// returns: the address in the admin slot of the proxy, which may be a ProxyAdmin contract
+//
+// Example
+//
+//	admin, err := sdk.GetProxyAdmin(context.Background(), "{{contract_address}}")
+func (sdk *ThirdwebSDK) GetProxyAdmin(ctx context.Context, proxyAddress string) (string, error) {
+	if !sdk.isValidAddress(proxyAddress) {
+		return "", ErrInvalidAddress
+	}
+
+	slot, err := sdk.GetProvider().StorageAt(ctx, common.HexToAddress(proxyAddress), common.HexToHash(eip1967AdminSlot), nil)
+	if err != nil {
+		return "", err
+	}
+
+	adminAddress := common.BytesToAddress(slot)
+	if adminAddress == (common.Address{}) {
+		return "", fmt.Errorf("Contract at '%s' has no EIP-1967 proxy admin", proxyAddress)
+	}
+
+	return adminAddress.String(), nil
+}
+
+const proxyAdminAbi = `[{
+	"type": "function",
+	"name": "changeAdmin",
+	"stateMutability": "nonpayable",
+	"inputs": [{"name": "newAdmin", "type": "address"}],
+	"outputs": []
+}, {
+	"type": "function",
+	"name": "changeProxyAdmin",
+	"stateMutability": "nonpayable",
+	"inputs": [{"name": "proxy", "type": "address"}, {"name": "newAdmin", "type": "address"}],
+	"outputs": []
+}]`
+
+// ChangeProxyAdmin
+//
+// # Transfer the admin of an EIP-1967 proxy contract, which requires the connected wallet to control the current admin
+//
+// If the current admin is a ProxyAdmin contract, the change is sent through it. Otherwise the
+// connected wallet must be the admin itself.
+//
+// proxyAddress: the address of the proxy contract
+//
+// newAdmin: the address of the new admin
+//
+// returns: the transaction receipt of the admin change
+//
+// Example
+//
+//	tx, err := sdk.ChangeProxyAdmin(context.Background(), "{{contract_address}}", "0x...")
+func (sdk *ThirdwebSDK) ChangeProxyAdmin(ctx context.Context, proxyAddress string, newAdmin string, options ...*TransactionOptions) (*types.Transaction, error) {
+	if !sdk.isValidAddress(proxyAddress) || !sdk.isValidAddress(newAdmin) {
+		return nil, ErrInvalidAddress
+	}
+
+	currentAdmin, err := sdk.GetProxyAdmin(ctx, proxyAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	adminCode, err := sdk.GetProvider().CodeAt(ctx, common.HexToAddress(currentAdmin), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedAbi, err := gethAbi.JSON(strings.NewReader(proxyAdminAbi))
+	if err != nil {
+		return nil, err
+	}
+
+	// A ProxyAdmin contract forwards the change, otherwise the admin calls the proxy directly
+	target := common.HexToAddress(proxyAddress)
+	method := "changeAdmin"
+	args := []interface{}{common.HexToAddress(newAdmin)}
+	if len(adminCode) > 0 {
+		target = common.HexToAddress(currentAdmin)
+		method = "changeProxyAdmin"
+		args = []interface{}{common.HexToAddress(proxyAddress), common.HexToAddress(newAdmin)}
+	}
+
+	helper, err := newContractHelper(target, sdk.ProviderHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	txOpts, err := helper.GetTxOptions(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := sdk.getBackend()
+	contract := bind.NewBoundContract(target, parsedAbi, backend, backend, backend)
+	tx, err := contract.Transact(txOpts, method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.AwaitTx(ctx, tx.Hash())
+}
+
 // GetContractFromABI
 //
 // # Get an instance of ant custom contract from its ABI
@@ -209,14 +673,630 @@ func (sdk *ThirdwebSDK) GetContract(ctx context.Context, address string) (*Smart
 // abi: the ABI of the contract
 func (sdk *ThirdwebSDK) GetContractFromAbi(address string, abi string) (*SmartContract, error) {
 	return newSmartContract(
-		sdk.GetProvider(),
+		sdk.ProviderHandler,
 		common.HexToAddress(address),
 		abi,
-		sdk.GetRawPrivateKey(),
 		&sdk.Storage,
 	)
 }
 
+// GetContractEvents
+//
+// # Query the past events of a specific type emitted by any contract
+//
+// Large block ranges are split into chunks of 5000 blocks, since RPCs limit the range of a
+// single log query.
+//
+// address: the address of the contract
+//
+// abiJSON: the ABI of the contract, which must contain the event
+//
+// eventName: the name of the event to query
+//
+// from: the first block to query, defaults to block 0 if nil
+//
+// to: the last block to query, defaults to the latest block if nil
+//
+// returns: the decoded events, in the order they were emitted
+//
+// Example
+//
+//	events, err := sdk.GetContractEvents(context.Background(), "{{contract_address}}", abi, "Transfer", big.NewInt(16000000), nil)
+//	for _, event := range events {
+//		fmt.Println(event.Transaction.BlockNumber, event.Data["from"], event.Data["to"])
+//	}
+func (sdk *ThirdwebSDK) GetContractEvents(
+	ctx context.Context,
+	address string,
+	abiJSON string,
+	eventName string,
+	from *big.Int,
+	to *big.Int,
+) ([]*ContractEvent, error) {
+	if !sdk.isValidAddress(address) {
+		return nil, ErrInvalidAddress
+	}
+
+	helper, err := newContractHelper(common.HexToAddress(address), sdk.ProviderHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	contractEvents, err := newContractEvents(abiJSON, helper)
+	if err != nil {
+		return nil, err
+	}
+
+	eventAbi, ok := contractEvents.abi.Events[eventName]
+	if !ok {
+		return nil, fmt.Errorf("Event with name '%s' not found", eventName)
+	}
+
+	provider := sdk.GetProvider()
+
+	start := uint64(0)
+	if from != nil {
+		start = from.Uint64()
+	}
+
+	var end uint64
+	if to != nil {
+		end = to.Uint64()
+	} else {
+		end, err = provider.BlockNumber(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	events := []*ContractEvent{}
+	for chunkStart := start; chunkStart <= end; chunkStart += logsBlockRange {
+		chunkEnd := chunkStart + logsBlockRange - 1
+		if chunkEnd > end {
+			chunkEnd = end
+		}
+
+		logs, err := provider.FilterLogs(ctx, ethereum.FilterQuery{
+			Addresses: []common.Address{helper.getAddress()},
+			Topics:    [][]common.Hash{{eventAbi.ID}},
+			FromBlock: new(big.Int).SetUint64(chunkStart),
+			ToBlock:   new(big.Int).SetUint64(chunkEnd),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, eventLog := range logs {
+			event, err := contractEvents.transformEvent(eventName, eventLog)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, &event)
+		}
+	}
+
+	return events, nil
+}
+
+// GetNativeToken
+//
+// # Get the native token of the connected chain
+//
+// returns: the name, symbol and decimals of the native token, and its wrapped ERC20 token
+//
+// Example
+//
+//	nativeToken, err := sdk.GetNativeToken(context.Background())
+//	symbol := nativeToken.Symbol // "ETH" on mainnet, "MATIC" on Polygon
+func (sdk *ThirdwebSDK) GetNativeToken(ctx context.Context) (*NativeToken, error) {
+	chainId, err := sdk.GetChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return getNativeTokenByChainId(ChainID(chainId.Int64()))
+}
+
+// GetWalletNFTs
+//
+// # Get all the NFTs owned by a wallet across every contract
+//
+// With an Alchemy API key, set with the AlchemyApiKey SDK option or WithAlchemyAPIKey, this uses
+// the Alchemy NFT API, which is much faster than enumerating tokens on-chain and finds the NFTs
+// of every contract. Otherwise only the contracts passed in are enumerated on-chain, since there's
+// no way to find the contracts a wallet holds NFTs in from the chain alone.
+//
+// address: the address of the wallet
+//
+// contractAddresses: the ERC721 and ERC1155 contracts to enumerate when Alchemy isn't used
+//
+// returns: the NFTs owned by the wallet along with their metadata
+//
+// Example
+//
+//	nfts, err := sdk.GetWalletNFTs(context.Background(), "{{wallet_address}}")
+//	name := nfts[0].Metadata.Name
+func (sdk *ThirdwebSDK) GetWalletNFTs(ctx context.Context, address string, contractAddresses ...string) ([]*OwnedNFT, error) {
+	if sdk.alchemy != nil {
+		chainId, err := sdk.GetChainID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return sdk.alchemy.getNFTs(ctx, ChainID(chainId.Int64()), address)
+	}
+
+	nfts := []*OwnedNFT{}
+	for _, contractAddress := range contractAddresses {
+		owned, err := sdk.getOwnedNFTs(ctx, contractAddress, address)
+		if err != nil {
+			return nil, err
+		}
+		nfts = append(nfts, owned...)
+	}
+
+	return nfts, nil
+}
+
+// Enumerates the NFTs a wallet owns in a single contract on-chain
+func (sdk *ThirdwebSDK) getOwnedNFTs(ctx context.Context, contractAddress string, owner string) ([]*OwnedNFT, error) {
+	erc165, err := abi.NewIERC165(common.HexToAddress(contractAddress), sdk.GetProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	isErc721, err := erc165.SupportsInterface(&bind.CallOpts{Context: ctx}, [4]byte{0x80, 0xAC, 0x58, 0xCD})
+	if err != nil {
+		return nil, err
+	}
+
+	nfts := []*OwnedNFT{}
+	if isErc721 {
+		erc721, err := newERC721(sdk.ProviderHandler, common.HexToAddress(contractAddress), &sdk.Storage)
+		if err != nil {
+			return nil, err
+		}
+
+		owned, err := erc721.GetOwned(ctx, owner)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, nft := range owned {
+			nfts = append(nfts, &OwnedNFT{
+				ContractAddress: contractAddress,
+				TokenId:         nft.Metadata.Id,
+				TokenType:       "ERC721",
+				Balance:         1,
+				Metadata:        nft.Metadata,
+			})
+		}
+		return nfts, nil
+	}
+
+	isErc1155, err := erc165.SupportsInterface(&bind.CallOpts{Context: ctx}, [4]byte{0xD9, 0xB6, 0x7A, 0x26})
+	if err != nil {
+		return nil, err
+	}
+	if !isErc1155 {
+		return nil, fmt.Errorf("Contract %s is neither an ERC721 nor an ERC1155 contract", contractAddress)
+	}
+
+	erc1155, err := newERC1155(sdk.ProviderHandler, common.HexToAddress(contractAddress), &sdk.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	owned, err := erc1155.GetOwned(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, nft := range owned {
+		nfts = append(nfts, &OwnedNFT{
+			ContractAddress: contractAddress,
+			TokenId:         nft.Metadata.Id,
+			TokenType:       "ERC1155",
+			Balance:         nft.QuantityOwned,
+			Metadata:        nft.Metadata,
+		})
+	}
+	return nfts, nil
+}
+
+// WithAlchemyAPIKey
+//
+// # Use the Alchemy enhanced APIs for wallet lookups, like the AlchemyApiKey SDK option
+//
+// apiKey: the Alchemy API key
+//
+// returns: the SDK, so the call can be chained
+//
+// Example
+//
+//	nfts, err := sdk.WithAlchemyAPIKey("{{alchemy_api_key}}").GetWalletNFTs(context.Background(), "{{wallet_address}}")
+func (sdk *ThirdwebSDK) WithAlchemyAPIKey(apiKey string) *ThirdwebSDK {
+	sdk.alchemy = newAlchemyClient(apiKey, sdk.httpClient)
+	return sdk
+}
+
+// GetWalletBalance
+//
+// # Get the native token balance and the ERC20 token balances of a wallet
+//
+// If an AlchemyApiKey is set in the SDK options, every ERC20 token held by the wallet is found
+// with the Alchemy token API. Otherwise only the tokens passed in are checked on-chain, since
+// there's no way to find the tokens a wallet holds from the chain alone. Their balances are read
+// in a single call through Multicall3, or with one call per token on chains where Multicall3
+// isn't deployed.
+//
+// address: the address of the wallet
+//
+// tokenAddresses: the ERC20 tokens to check the balance of when Alchemy isn't used
+//
+// returns: the native balance and the non zero ERC20 balances of the wallet
+//
+// Example
+//
+//	balance, err := sdk.GetWalletBalance(context.Background(), "{{wallet_address}}")
+//	nativeBalance := balance.Native.DisplayValue
+func (sdk *ThirdwebSDK) GetWalletBalance(ctx context.Context, address string, tokenAddresses ...string) (*WalletBalance, error) {
+	provider := sdk.GetProvider()
+
+	nativeBalance, err := provider.BalanceAt(ctx, common.HexToAddress(address), nil)
+	if err != nil {
+		return nil, err
+	}
+	native, err := fetchCurrencyValue(ctx, provider, zeroAddress, nativeBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := map[string]*big.Int{}
+	if sdk.alchemy != nil {
+		chainId, err := sdk.GetChainID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		balances, err = sdk.alchemy.getTokenBalances(ctx, ChainID(chainId.Int64()), address)
+		if err != nil {
+			return nil, err
+		}
+	} else if len(tokenAddresses) > 0 {
+		tokenBalances, err := getERC20BalancesWithMulticall(ctx, provider, address, tokenAddresses)
+		if err != nil {
+			// Calls to an address without code succeed with no data, which fails to decode
+			tokenBalances, err = getERC20Balances(ctx, provider, address, tokenAddresses)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for i, balance := range tokenBalances {
+			if balance != nil && balance.Sign() > 0 {
+				balances[tokenAddresses[i]] = balance
+			}
+		}
+	}
+
+	tokens := []*WalletTokenBalance{}
+	for tokenAddress, balance := range balances {
+		value, err := fetchCurrencyValue(ctx, provider, tokenAddress, balance)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, &WalletTokenBalance{
+			ContractAddress: tokenAddress,
+			Balance:         value,
+		})
+	}
+
+	// Map iteration order is random, so we sort to keep the output stable
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].ContractAddress < tokens[j].ContractAddress
+	})
+
+	return &WalletBalance{
+		Native: native,
+		Tokens: tokens,
+	}, nil
+}
+
+// RegisterWebhook
+//
+// Register a URL with thirdweb Engine to be called on transaction events. This requires an
+// EngineUrl and EngineAccessToken to be set in the SDK options.
+//
+// url: the URL that Engine should call
+//
+// events: the events to register for, any of "transaction.queued", "transaction.sent",
+// "transaction.mined" and "transaction.failed"
+//
+// returns: the registration, whose ID can be passed to UnregisterWebhook
+//
+// Example
+//
+//	registration, err := sdk.RegisterWebhook(
+//		context.Background(),
+//		"https://example.com/webhook",
+//		[]string{"transaction.mined", "transaction.failed"},
+//	)
+func (sdk *ThirdwebSDK) RegisterWebhook(ctx context.Context, url string, events []string) (*WebhookRegistration, error) {
+	if sdk.engine == nil {
+		return nil, fmt.Errorf("Registering a webhook requires an EngineUrl in the SDK options")
+	}
+	if len(events) == 0 {
+		return nil, fmt.Errorf("At least one webhook event is required")
+	}
+
+	ids := []string{}
+	secrets := map[string]string{}
+	for _, event := range events {
+		webhook, err := sdk.engine.createWebhook(ctx, url, event)
+		if err != nil {
+			// Don't leave behind the webhooks for the events that did register
+			for _, id := range ids {
+				sdk.engine.revokeWebhook(ctx, id)
+			}
+			return nil, err
+		}
+
+		ids = append(ids, strconv.Itoa(webhook.Id))
+		secrets[event] = webhook.Secret
+	}
+
+	return &WebhookRegistration{
+		Id:      strings.Join(ids, ","),
+		Url:     url,
+		Events:  events,
+		Secrets: secrets,
+	}, nil
+}
+
+// UnregisterWebhook
+//
+// Remove a webhook registered with RegisterWebhook.
+//
+// id: the ID of the webhook registration
+//
+// Example
+//
+//	err := sdk.UnregisterWebhook(context.Background(), registration.Id)
+func (sdk *ThirdwebSDK) UnregisterWebhook(ctx context.Context, id string) error {
+	if sdk.engine == nil {
+		return fmt.Errorf("Unregistering a webhook requires an EngineUrl in the SDK options")
+	}
+
+	for _, webhookId := range strings.Split(id, ",") {
+		if err := sdk.engine.revokeWebhook(ctx, webhookId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// QueueTransaction
+//
+// Queue a transaction with thirdweb Engine instead of sending it directly. Engine sends it from its
+// backend wallet and handles the nonce, gas estimation and retries of stuck transactions. This
+// requires an EngineUrl, EngineAccessToken and EngineBackendWallet to be set in the SDK options.
+//
+// tx: the transaction to queue, which can be built with the Encoder of a contract
+//
+// returns: the queued transaction, which can be waited on until it's mined
+//
+// Example
+//
+//	tx, err := contract.Encoder.Encode(context.Background(), backendWallet, "mintTo", to, uri)
+//	queued, err := sdk.QueueTransaction(context.Background(), tx)
+//	receipt, err := queued.Wait(context.Background())
+func (sdk *ThirdwebSDK) QueueTransaction(ctx context.Context, tx *types.Transaction) (*QueuedTransaction, error) {
+	if sdk.engine == nil {
+		return nil, fmt.Errorf("Queueing a transaction requires an EngineUrl in the SDK options")
+	}
+
+	chainId, err := sdk.GetChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queueId, err := sdk.engine.sendTransaction(ctx, chainId, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueuedTransaction{
+		QueueId:  queueId,
+		engine:   sdk.engine,
+		provider: sdk.GetProvider(),
+	}, nil
+}
+
+// SendRawTransaction
+//
+// # Broadcast a transaction that was already signed elsewhere, like on a hardware wallet
+//
+// signedTxHex: the hex encoded signed transaction
+//
+// returns: the hash of the broadcasted transaction
+//
+// Example
+//
+//	hash, err := sdk.SendRawTransaction(context.Background(), "0x02f8...")
+func (sdk *ThirdwebSDK) SendRawTransaction(ctx context.Context, signedTxHex string) (string, error) {
+	encodedTx, err := hexutil.Decode(signedTxHex)
+	if err != nil {
+		return "", err
+	}
+
+	tx := &types.Transaction{}
+	if err := tx.UnmarshalBinary(encodedTx); err != nil {
+		return "", err
+	}
+
+	if err := sdk.GetProvider().SendTransaction(ctx, tx); err != nil {
+		return "", err
+	}
+
+	return tx.Hash().String(), nil
+}
+
+// WatchTransaction
+//
+// # Watch a transaction in the background until it's mined
+//
+// This is useful for transactions sent outside of the SDK, like from a frontend. The transaction
+// is considered mined once the BlockConfirmations from the SDK options have passed.
+//
+// txHash: the hash of the transaction to watch
+//
+// returns: a watcher whose Done channel is closed once the transaction is mined or watching fails
+//
+// Example
+//
+//	watcher, err := sdk.WatchTransaction(context.Background(), "0x...")
+//
+//	<-watcher.Done()
+//	if watcher.Err() != nil {
+//		return watcher.Err()
+//	}
+//	fmt.Println("Mined in block", watcher.Receipt().BlockNumber)
+func (sdk *ThirdwebSDK) WatchTransaction(ctx context.Context, txHash string) (*TransactionWatcher, error) {
+	hash, err := hexutil.Decode(txHash)
+	if err != nil {
+		return nil, err
+	}
+	if len(hash) != common.HashLength {
+		return nil, fmt.Errorf("Invalid transaction hash %s", txHash)
+	}
+
+	helper := &contractHelper{ProviderHandler: sdk.ProviderHandler}
+	watcher := newTransactionWatcher(common.BytesToHash(hash), helper)
+	go watcher.watch(ctx)
+
+	return watcher, nil
+}
+
+// WatchPendingTransactions
+//
+// # Subscribe to transactions entering the mempool
+//
+// This requires a WebSocket RPC URL, and an SDK created with NewThirdwebSDK.
+//
+// sink: the channel to send the full pending transactions to
+//
+// returns: the subscription, which must be unsubscribed from once you're done
+//
+// Example
+//
+//	pending := make(chan *types.Transaction)
+//	sub, err := sdk.WatchPendingTransactions(context.Background(), pending)
+//	defer sub.Unsubscribe()
+//
+//	for tx := range pending {
+//		fmt.Println("Pending transaction:", tx.Hash())
+//	}
+func (sdk *ThirdwebSDK) WatchPendingTransactions(ctx context.Context, sink chan<- *types.Transaction) (event.Subscription, error) {
+	if sdk.connection == nil {
+		return nil, fmt.Errorf("Watching pending transactions requires an SDK created with NewThirdwebSDK")
+	}
+
+	hashes := make(chan common.Hash)
+	sub, err := sdk.connection.getRpcClient().EthSubscribe(ctx, hashes, "newPendingTransactions")
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdk.GetProvider()
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case hash := <-hashes:
+				tx, _, err := provider.TransactionByHash(ctx, hash)
+				if err != nil {
+					// The transaction may already have been mined or dropped
+					continue
+				}
+
+				select {
+				case sink <- tx:
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// IsWebSocketConnected
+//
+// # Check whether the SDK is currently connected to a WebSocket RPC
+//
+// returns: false if the SDK isn't using a WebSocket RPC URL or is reconnecting after the connection dropped
+func (sdk *ThirdwebSDK) IsWebSocketConnected() bool {
+	if sdk.connection == nil || !sdk.connection.isWebSocket() {
+		return false
+	}
+	return sdk.connection.isConnected()
+}
+
+// Close
+//
+// # Close the RPC connections the SDK dialed, and stop reconnecting WebSocket RPC URLs
+//
+// The SDK can't be used once it's closed. SDKs created with NewThirdwebSDKFromProvider leave the
+// provider open.
+func (sdk *ThirdwebSDK) Close() {
+	if sdk.connection != nil {
+		sdk.connection.close()
+	}
+	if sdk.pool != nil {
+		sdk.pool.close()
+	}
+}
+
+// On
+//
+// # Add a handler to be called whenever the SDK emits an event of the given type
+//
+// eventType: the type of event to listen for (e.g. thirdweb.EventTransactionSent)
+//
+// handler: the function to call with the emitted event
+//
+// returns: a function that removes the handler
+//
+// Example
+//
+//	unsubscribe := sdk.On(thirdweb.EventTransactionConfirmed, func(event thirdweb.Event) {
+//		fmt.Println("Transaction confirmed:", event.Data["hash"])
+//	})
+//	defer unsubscribe()
+func (sdk *ThirdwebSDK) On(eventType string, handler func(Event)) func() {
+	return sdk.events.On(eventType, handler)
+}
+
+// Off
+//
+// # Remove a handler previously added with On
+//
+// Handlers are matched by their code pointer, so every closure created from the same function
+// literal is removed. Call the function returned by On to remove a single handler.
+//
+// eventType: the type of event the handler was added for
+//
+// handler: the function that was passed to On
+func (sdk *ThirdwebSDK) Off(eventType string, handler func(Event)) {
+	sdk.events.Off(eventType, handler)
+}
+
 func defaultRpc(network string) (string, error) {
 	defaultApiKey := "718c5c811c7f3224efb283e04faab56a8a5cbde78d92a6d4cb905b41985d3856"
 	return fmt.Sprintf("https://%s.rpc.thirdweb.com/%s", network, defaultApiKey), nil
@@ -245,10 +1325,12 @@ func getDefaultRpcUrl(rpcUrlorName string) (string, error) {
 	case "arbitrum-goerli":
 		return defaultRpc("arbitrum-goerli")
 	default:
-		if strings.HasPrefix(rpcUrlorName, "http") {
-			return rpcUrlorName, nil
-		} else {
-			return "", fmt.Errorf("invalid rpc url or chain name: %s", rpcUrlorName)
+		// WebSocket URLs are dialed as a reconnecting connection, so they're as valid as HTTP ones
+		for _, scheme := range []string{"http://", "https://", "ws://", "wss://"} {
+			if strings.HasPrefix(rpcUrlorName, scheme) {
+				return rpcUrlorName, nil
+			}
 		}
+		return "", fmt.Errorf("invalid rpc url or chain name: %s", rpcUrlorName)
 	}
 }
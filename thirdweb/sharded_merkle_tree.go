@@ -78,11 +78,25 @@ func (tree *ShardedMerkleTree) HashEntry(
 	tokenDecimals int,
 	currencyDecimals int,
 ) (string, error) {
-	maxClaimable, err := convertQuantityToBigNumber(entry.MaxClaimable, tokenDecimals)
+	hash, err := hashSnapshotEntry(entry, tokenDecimals, currencyDecimals)
 	if err != nil {
 		return "", err
 	}
 
+	return hex.EncodeToString(hash), nil
+}
+
+// Hashes an entry the same way the drop contracts do when verifying a claim
+func hashSnapshotEntry(
+	entry *SnapshotEntry,
+	tokenDecimals int,
+	currencyDecimals int,
+) ([]byte, error) {
+	maxClaimable, err := convertQuantityToBigNumber(entry.MaxClaimable, tokenDecimals)
+	if err != nil {
+		return nil, err
+	}
+
 	entryPrice := entry.Price
 	if entryPrice == "" {
 		entryPrice = "unlimited"
@@ -90,7 +104,7 @@ func (tree *ShardedMerkleTree) HashEntry(
 
 	price, err := convertQuantityToBigNumber(entryPrice, currencyDecimals)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	currencyAddress := entry.CurrencyAddress
@@ -108,7 +122,7 @@ func (tree *ShardedMerkleTree) HashEntry(
 		},
 	)
 
-	return hex.EncodeToString(hash), nil
+	return hash, nil
 }
 
 func (tree *ShardedMerkleTree) GetProof(
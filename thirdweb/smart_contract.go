@@ -3,14 +3,12 @@ package thirdweb
 import (
 	"context"
 	"fmt"
-	"math/big"
-	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 )
 
 // Custom Contracts
@@ -74,28 +72,41 @@ import (
 //	// You can also make a transaction to your contract with the call method
 //	tx, err := contract.Call("mintTo", "{{wallet_address}}", "ipfs://...")
 type SmartContract struct {
-	abi      *abi.ABI
-	contract *bind.BoundContract
-	Helper   *contractHelper
-	Encoder  *ContractEncoder
-	Events   *ContractEvents
-	ERC20    *ERC20
-	ERC721   *ERC721
-	ERC1155  *ERC1155
+	abi         *abi.ABI
+	contract    *bind.BoundContract
+	middlewares []CallMiddleware
+	Helper      *contractHelper
+	Encoder     *ContractEncoder
+	Events      *ContractEvents
+	ERC20       *ERC20
+	ERC721      *ERC721
+	ERC1155     *ERC1155
 }
 
-func newSmartContract(provider *ethclient.Client, address common.Address, contractAbi string, privateKey string, storage storage) (*SmartContract, error) {
-	helper, err := newContractHelper(address, provider, privateKey)
+// Handles a contract call made with SmartContract.Call, once its arguments have been converted to
+// the types expected by the ABI. Read calls return the outputs of the function, and transactions
+// return the mined transaction.
+type CallHandler func(ctx context.Context, method string, args []interface{}) ([]interface{}, error)
+
+// Wraps the handling of contract calls, to add rate limiting, tracing or logging for example. A
+// middleware can inspect or change the call before passing it on to next, and the result after.
+type CallMiddleware func(next CallHandler) CallHandler
+
+func newSmartContract(handler *ProviderHandler, address common.Address, contractAbi string, storage storage) (*SmartContract, error) {
+	backend := handler.getBackend()
+
+	helper, err := newContractHelper(address, handler)
 	if err != nil {
 		return nil, err
 	}
+	helper.contractAbi = contractAbi
 
 	parsedAbi, err := abi.JSON(strings.NewReader(contractAbi))
 	if err != nil {
 		return nil, err
 	}
 
-	boundContract := bind.NewBoundContract(address, parsedAbi, provider, provider, provider)
+	boundContract := bind.NewBoundContract(address, parsedAbi, backend, backend, backend)
 
 	encoder, err := newContractEncoder(contractAbi, helper)
 	if err != nil {
@@ -107,17 +118,17 @@ func newSmartContract(provider *ethclient.Client, address common.Address, contra
 		return nil, err
 	}
 
-	erc20, err := newERC20(provider, address, privateKey, storage)
+	erc20, err := newERC20(handler, address, storage)
 	if err != nil {
 		return nil, err
 	}
 
-	erc721, err := newERC721(provider, address, privateKey, storage)
+	erc721, err := newERC721(handler, address, storage)
 	if err != nil {
 		return nil, err
 	}
 
-	erc1155, err := newERC1155(provider, address, privateKey, storage)
+	erc1155, err := newERC1155(handler, address, storage)
 	if err != nil {
 		return nil, err
 	}
@@ -150,81 +161,205 @@ func newSmartContract(provider *ethclient.Client, address common.Address, contra
 //
 //	// You can also make a transaction to your contract with the call method
 //	tx, err := contract.Call(context.Background(), "mintTo", "{{wallet_address}}", "ipfs://...")
+//
+//	// In simulation mode, transactions return a SimulationResult with the decoded return values
+//	result, err := contract.Call(context.Background(), "mintTo", "{{wallet_address}}", "ipfs://...")
+//	tokenId := result.(*thirdweb.SimulationResult).Outputs[0]
 func (c *SmartContract) Call(ctx context.Context, method string, args ...interface{}) (interface{}, error) {
 	abiMethod, exist := c.abi.Methods[method]
 	if !exist {
 		return nil, fmt.Errorf("function '%s' not found in contract '%s'", method, c.Helper.getAddress().String())
 	}
 
-	if len(abiMethod.Inputs) != len(args) {
-		return nil, fmt.Errorf(
-			"function '%s' requires %d arguments, but %d arguments were provided.\nExpected function signature '%s'",
-			method,
-			len(abiMethod.Inputs),
-			len(args),
-			abiMethod.Sig,
-		)
-	}
-
-	// Validate argument input types and convert to proper input types for contract
-	// So we can allow users to pass in string intead of address, int instead of big, etc.
-	typedArgs := []interface{}{}
-	for i, arg := range args {
-		input := abiMethod.Inputs[i]
-		inputType := fmt.Sprint(input.Type)
-
-		if inputType == "address" {
-			parsedArg, ok := arg.(string)
-			if !ok {
-				return nil, fmt.Errorf(
-					"argument %d (%v) should be of type 'string', but type '%v' was provided",
-					i,
-					input.Name,
-					reflect.TypeOf(arg),
-				)
-			}
-
-			arg = common.HexToAddress(parsedArg)
-		} else if strings.Contains(inputType, "int") {
-			parsedArg, ok := arg.(int)
-			if !ok {
-				return nil, fmt.Errorf(
-					"argument %d (%v) should be of type 'int', but type '%v' was provided",
-					i,
-					input.Name,
-					reflect.TypeOf(arg),
-				)
-			}
+	// Convert the arguments to the types the ABI encoder expects, so callers can pass a string
+	// instead of an address, an int instead of a big.Int, etc.
+	typedArgs, err := coerceArgs(abiMethod, args, c.Helper.strictAddressChecksums)
+	if err != nil {
+		return nil, err
+	}
 
-			arg = big.NewInt(int64(parsedArg))
-		}
+	handler := chainCallMiddlewares(c.invoke, c.middlewares)
+	out, err := handler(ctx, method, typedArgs)
+	if err != nil {
+		return nil, err
+	}
 
-		typedArgs = append(typedArgs, arg)
+	// If theres only one return value, return it directly instead of the tuple
+	if len(out) == 1 {
+		return out[0], nil
 	}
 
+	return out, nil
+}
+
+// Add middlewares that every call made with Call goes through. Middlewares run in the order
+// they're added, so the first one added sees the call first.
+//
+// middlewares: the middlewares to add
+//
+// Example
+//
+//	logger := func(next thirdweb.CallHandler) thirdweb.CallHandler {
+//		return func(ctx context.Context, method string, args []interface{}) ([]interface{}, error) {
+//			start := time.Now()
+//			out, err := next(ctx, method, args)
+//			log.Printf("%s took %v", method, time.Since(start))
+//			return out, err
+//		}
+//	}
+//
+//	contract.Use(logger)
+func (c *SmartContract) Use(middlewares ...CallMiddleware) {
+	c.middlewares = append(c.middlewares, middlewares...)
+}
+
+func (c *SmartContract) invoke(ctx context.Context, method string, args []interface{}) ([]interface{}, error) {
+	abiMethod := c.abi.Methods[method]
 	if abiMethod.StateMutability == "view" || abiMethod.StateMutability == "pure" {
 		var out []interface{}
-		err := c.contract.Call(&bind.CallOpts{Context: ctx}, &out, method, typedArgs...)
+		err := c.contract.Call(&bind.CallOpts{Context: ctx}, &out, method, args...)
 		if err != nil {
 			return nil, err
 		}
 
-		// If theres only one return value, return it directly instead of the tuple
-		if len(out) == 1 {
-			return out[0], nil
-		}
-
 		return out, nil
 	} else {
 		txOpts, err := c.Helper.GetTxOptions(ctx)
 		if err != nil {
 			return nil, err
 		}
-		tx, err := c.contract.Transact(txOpts, method, typedArgs...)
+		tx, err := c.contract.Transact(txOpts, method, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		// Simulated transactions were never sent, so return what the function would have returned
+		if simulated, ok := c.Helper.simulatedTxs.LoadAndDelete(tx.Hash()); ok {
+			result, err := newSimulationResult(abiMethod, simulated.(*simulatedTx))
+			if err != nil {
+				return nil, err
+			}
+
+			return []interface{}{result}, nil
+		}
+
+		minedTx, err := c.Helper.AwaitTx(ctx, tx.Hash())
 		if err != nil {
 			return nil, err
 		}
 
-		return c.Helper.AwaitTx(ctx, tx.Hash())
+		return []interface{}{minedTx}, nil
+	}
+}
+
+func chainCallMiddlewares(handler CallHandler, middlewares []CallMiddleware) CallHandler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
+
+// Check if the contract is an upgradeable EIP-1967 proxy, either a transparent, UUPS or beacon
+// proxy. The ABI of a proxy contract comes from its implementation.
+//
+// returns: true if the contract is a proxy
+//
+// Example
+//
+//	isProxy, err := contract.IsProxy(context.Background())
+func (c *SmartContract) IsProxy(ctx context.Context) (bool, error) {
+	implementationAddress, err := fetchEIP1967ImplementationAddress(ctx, c.Helper.getAddress().String(), c.Helper.GetProvider())
+	if err != nil {
+		return false, err
 	}
+
+	return implementationAddress != "", nil
+}
+
+// Get the address of the implementation contract a proxy delegates its calls to. Calls still go
+// to the proxy, this is just so the proxy can be inspected.
+//
+// returns: the current implementation address of the proxy
+//
+// Example
+//
+//	implementation, err := contract.GetImplementationAddress(context.Background())
+func (c *SmartContract) GetImplementationAddress(ctx context.Context) (string, error) {
+	implementationAddress, err := fetchEIP1967ImplementationAddress(ctx, c.Helper.getAddress().String(), c.Helper.GetProvider())
+	if err != nil {
+		return "", err
+	}
+	if implementationAddress == "" {
+		return "", fmt.Errorf("Contract at '%s' is not an EIP-1967 proxy", c.Helper.getAddress().String())
+	}
+
+	return implementationAddress, nil
+}
+
+// Get the signatures of all the functions on your contract.
+//
+// returns: the function signatures sorted alphabetically
+//
+// Example
+//
+//	signatures := contract.GetFunctionSignatures()
+//	// ["balanceOf(address)", "transfer(address,uint256)", ...]
+func (c *SmartContract) GetFunctionSignatures() []string {
+	signatures := []string{}
+	for _, method := range c.abi.Methods {
+		signatures = append(signatures, method.Sig)
+	}
+
+	sort.Strings(signatures)
+	return signatures
+}
+
+// Get the signatures of all the events on your contract.
+//
+// returns: the event signatures sorted alphabetically
+//
+// Example
+//
+//	signatures := contract.GetEventSignatures()
+//	// ["Approval(address,address,uint256)", "Transfer(address,address,uint256)", ...]
+func (c *SmartContract) GetEventSignatures() []string {
+	signatures := []string{}
+	for _, event := range c.abi.Events {
+		signatures = append(signatures, event.Sig)
+	}
+
+	sort.Strings(signatures)
+	return signatures
+}
+
+// Get the ABI descriptor of an event on your contract from its signature.
+//
+// signature: the signature of the event, e.g. "Transfer(address,address,uint256)"
+//
+// returns: the event descriptor, which can be used to decode logs of the event
+//
+// Example
+//
+//	event, err := contract.GetEventBySignature("Transfer(address,address,uint256)")
+func (c *SmartContract) GetEventBySignature(signature string) (*abi.Event, error) {
+	for _, event := range c.abi.Events {
+		if event.Sig == signature {
+			return &event, nil
+		}
+	}
+
+	return nil, fmt.Errorf("event '%s' not found in contract '%s'", signature, c.Helper.getAddress().String())
+}
+
+// Get the parsed ABI of your contract, the same one the SDK uses to encode calls and decode results.
+// This can be used to build custom calldata or decode logs and return data yourself.
+//
+// returns: the parsed ABI of the contract
+//
+// Example
+//
+//	contractAbi := contract.GetABI()
+//	data, err := contractAbi.Pack("transfer", to, amount)
+func (c *SmartContract) GetABI() abi.ABI {
+	return *c.abi
 }
@@ -0,0 +1,33 @@
+package thirdweb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallMiddlewareOrder(t *testing.T) {
+	calls := []string{}
+	record := func(name string) CallMiddleware {
+		return func(next CallHandler) CallHandler {
+			return func(ctx context.Context, method string, args []interface{}) ([]interface{}, error) {
+				calls = append(calls, name)
+				return next(ctx, method, args)
+			}
+		}
+	}
+
+	handler := chainCallMiddlewares(
+		func(ctx context.Context, method string, args []interface{}) ([]interface{}, error) {
+			calls = append(calls, method)
+			return args, nil
+		},
+		[]CallMiddleware{record("first"), record("second")},
+	)
+
+	out, err := handler(context.Background(), "balanceOf", []interface{}{1})
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{1}, out)
+	assert.Equal(t, []string{"first", "second", "balanceOf"}, calls)
+}
@@ -0,0 +1,412 @@
+package thirdweb
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	ethAbi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const smartWalletAbi = `[{
+	"type": "function",
+	"name": "createAccount",
+	"stateMutability": "nonpayable",
+	"inputs": [
+		{"name": "admin", "type": "address"},
+		{"name": "data", "type": "bytes"}
+	],
+	"outputs": [{"name": "", "type": "address"}]
+}, {
+	"type": "function",
+	"name": "getAddress",
+	"stateMutability": "view",
+	"inputs": [
+		{"name": "adminSigner", "type": "address"},
+		{"name": "data", "type": "bytes"}
+	],
+	"outputs": [{"name": "", "type": "address"}]
+}, {
+	"type": "function",
+	"name": "execute",
+	"stateMutability": "nonpayable",
+	"inputs": [
+		{"name": "target", "type": "address"},
+		{"name": "value", "type": "uint256"},
+		{"name": "calldata", "type": "bytes"}
+	],
+	"outputs": []
+}, {
+	"type": "function",
+	"name": "getNonce",
+	"stateMutability": "view",
+	"inputs": [
+		{"name": "sender", "type": "address"},
+		{"name": "key", "type": "uint192"}
+	],
+	"outputs": [{"name": "nonce", "type": "uint256"}]
+}]`
+
+// Signature used while estimating gas, bundlers simulate the operation so it only needs to be
+// well formed
+var dummyUserOperationSignature = hexutil.MustDecode("0xfffffffffffffffffffffffffffffff0000000000000000000000000000000007aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa1c")
+
+// An ERC-4337 user operation, as defined by the v0.6 entry point
+type UserOperation struct {
+	Sender               common.Address
+	Nonce                *big.Int
+	InitCode             []byte
+	CallData             []byte
+	CallGasLimit         *big.Int
+	VerificationGasLimit *big.Int
+	PreVerificationGas   *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	PaymasterAndData     []byte
+	Signature            []byte
+}
+
+type UserOperationGas struct {
+	PreVerificationGas   *big.Int
+	VerificationGasLimit *big.Int
+	CallGasLimit         *big.Int
+}
+
+type userOperationJSON struct {
+	Sender               common.Address `json:"sender"`
+	Nonce                *hexutil.Big   `json:"nonce"`
+	InitCode             hexutil.Bytes  `json:"initCode"`
+	CallData             hexutil.Bytes  `json:"callData"`
+	CallGasLimit         *hexutil.Big   `json:"callGasLimit"`
+	VerificationGasLimit *hexutil.Big   `json:"verificationGasLimit"`
+	PreVerificationGas   *hexutil.Big   `json:"preVerificationGas"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+	PaymasterAndData     hexutil.Bytes  `json:"paymasterAndData"`
+	Signature            hexutil.Bytes  `json:"signature"`
+}
+
+type userOperationGasJSON struct {
+	PreVerificationGas   *hexutil.Big `json:"preVerificationGas"`
+	VerificationGasLimit *hexutil.Big `json:"verificationGasLimit"`
+	CallGasLimit         *hexutil.Big `json:"callGasLimit"`
+}
+
+type userOperationReceiptJSON struct {
+	Success bool `json:"success"`
+	Receipt struct {
+		TransactionHash common.Hash `json:"transactionHash"`
+	} `json:"receipt"`
+}
+
+// A smart wallet sends the transactions of the SDK as ERC-4337 user operations from a smart
+// contract account owned by the SDK wallet. The account is deployed by the factory along with its
+// first operation, and the bundler submits the operations to the entry point.
+//
+// You can enable it with the SmartWallet SDK option, after which every write call goes through the
+// smart wallet, or use it directly:
+//
+//	address, err := sdk.SmartWallet.GetAddress(context.Background())
+type SmartWallet struct {
+	handler    *ProviderHandler
+	factory    common.Address
+	entryPoint common.Address
+	bundler    *rpc.Client
+	abi        ethAbi.ABI
+}
+
+func newSmartWallet(handler *ProviderHandler, options *SmartWalletOptions) (*SmartWallet, error) {
+	bundler, err := rpc.Dial(options.BundlerUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedAbi, err := ethAbi.JSON(strings.NewReader(smartWalletAbi))
+	if err != nil {
+		return nil, err
+	}
+
+	return &SmartWallet{
+		handler:    handler,
+		factory:    common.HexToAddress(options.FactoryAddress),
+		entryPoint: common.HexToAddress(options.EntryPointAddress),
+		bundler:    bundler,
+		abi:        parsedAbi,
+	}, nil
+}
+
+// Get the address of the smart wallet
+//
+// returns: the address of the smart wallet of the SDK wallet, which is known before it's deployed
+func (wallet *SmartWallet) GetAddress(ctx context.Context) (common.Address, error) {
+	result, err := wallet.call(ctx, wallet.factory, "getAddress", wallet.handler.GetSignerAddress(), []byte{})
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return result[0].(common.Address), nil
+}
+
+// Send sends the call of a signed transaction as a user operation, and waits for the bundler to
+// include it. It lets the smart wallet be used as the broadcaster of the SDK.
+func (wallet *SmartWallet) sponsorsGas() {}
+
+func (wallet *SmartWallet) Send(ctx context.Context, tx *types.Transaction) (common.Hash, error) {
+	if tx.To() == nil {
+		return common.Hash{}, fmt.Errorf("Smart wallets can't send contract deployments")
+	}
+
+	op, err := wallet.prepareUserOperation(ctx, *tx.To(), tx.Value(), tx.Data())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	opHash, err := wallet.SendUserOperation(ctx, op)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return wallet.waitForUserOperation(ctx, opHash)
+}
+
+// Estimate the gas limits of a user operation with the bundler
+//
+// op: the user operation to estimate, its signature can be left empty
+//
+// returns: the gas limits for the user operation
+func (wallet *SmartWallet) EstimateUserOperationGas(ctx context.Context, op *UserOperation) (*UserOperationGas, error) {
+	estimateOp := *op
+	if len(estimateOp.Signature) == 0 {
+		estimateOp.Signature = dummyUserOperationSignature
+	}
+
+	result := &userOperationGasJSON{}
+	if err := wallet.bundler.CallContext(ctx, result, "eth_estimateUserOperationGas", toUserOperationJSON(&estimateOp), wallet.entryPoint); err != nil {
+		return nil, err
+	}
+
+	return &UserOperationGas{
+		PreVerificationGas:   result.PreVerificationGas.ToInt(),
+		VerificationGasLimit: result.VerificationGasLimit.ToInt(),
+		CallGasLimit:         result.CallGasLimit.ToInt(),
+	}, nil
+}
+
+// Sign a user operation with the SDK wallet and send it to the bundler
+//
+// op: the user operation to send
+//
+// returns: the hash of the user operation
+func (wallet *SmartWallet) SendUserOperation(ctx context.Context, op *UserOperation) (string, error) {
+	privateKey := wallet.handler.GetPrivateKey()
+	if privateKey == nil {
+		return "", &noSignerError{typeName: "smart wallet"}
+	}
+
+	chainId, err := wallet.handler.GetChainID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	opHash, err := getUserOperationHash(op, wallet.entryPoint, chainId)
+	if err != nil {
+		return "", err
+	}
+
+	// The account verifies an EIP-191 signature of the operation hash
+	messageHash := crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n32%s", string(opHash.Bytes()))))
+	signature, err := crypto.Sign(messageHash, privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	// We need this to correct v = 0,1 to v = 27,28 - or else all will break
+	if signature[64] == 0 || signature[64] == 1 {
+		signature[64] += 27
+	}
+
+	signedOp := *op
+	signedOp.Signature = signature
+
+	var result string
+	if err := wallet.bundler.CallContext(ctx, &result, "eth_sendUserOperation", toUserOperationJSON(&signedOp), wallet.entryPoint); err != nil {
+		return "", err
+	}
+
+	return result, nil
+}
+
+func (wallet *SmartWallet) prepareUserOperation(ctx context.Context, to common.Address, value *big.Int, data []byte) (*UserOperation, error) {
+	sender, err := wallet.GetAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := wallet.handler.GetProvider()
+
+	// The factory deploys the account along with its first operation
+	initCode := []byte{}
+	code, err := provider.CodeAt(ctx, sender, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		createAccount, err := wallet.abi.Pack("createAccount", wallet.handler.GetSignerAddress(), []byte{})
+		if err != nil {
+			return nil, err
+		}
+		initCode = append(wallet.factory.Bytes(), createAccount...)
+	}
+
+	nonce, err := wallet.call(ctx, wallet.entryPoint, "getNonce", sender, big.NewInt(0))
+	if err != nil {
+		return nil, err
+	}
+
+	callData, err := wallet.abi.Pack("execute", to, value, data)
+	if err != nil {
+		return nil, err
+	}
+
+	helper := &contractHelper{ProviderHandler: wallet.handler}
+	gasPrice, tipCap, feeCap, err := helper.getGasFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if gasPrice != nil {
+		// Chains without EIP-1559 only have a gas price, which covers both fees
+		tipCap = gasPrice
+		feeCap = gasPrice
+	}
+
+	op := &UserOperation{
+		Sender:               sender,
+		Nonce:                nonce[0].(*big.Int),
+		InitCode:             initCode,
+		CallData:             callData,
+		MaxFeePerGas:         feeCap,
+		MaxPriorityFeePerGas: tipCap,
+		PaymasterAndData:     []byte{},
+		CallGasLimit:         big.NewInt(0),
+		VerificationGasLimit: big.NewInt(0),
+		PreVerificationGas:   big.NewInt(0),
+	}
+
+	gas, err := wallet.EstimateUserOperationGas(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+	op.CallGasLimit = gas.CallGasLimit
+	op.VerificationGasLimit = gas.VerificationGasLimit
+	op.PreVerificationGas = gas.PreVerificationGas
+
+	return op, nil
+}
+
+// Polls the bundler until the user operation is included, and returns the hash of the bundle
+// transaction that included it
+func (wallet *SmartWallet) waitForUserOperation(ctx context.Context, opHash string) (common.Hash, error) {
+	for attempts := 0; attempts < txMaxAttempts*3; attempts++ {
+		var receipt *userOperationReceiptJSON
+		if err := wallet.bundler.CallContext(ctx, &receipt, "eth_getUserOperationReceipt", opHash); err != nil {
+			return common.Hash{}, err
+		}
+
+		if receipt != nil {
+			if !receipt.Success {
+				return common.Hash{}, fmt.Errorf("User operation %s reverted in transaction %s", opHash, receipt.Receipt.TransactionHash.Hex())
+			}
+			return receipt.Receipt.TransactionHash, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return common.Hash{}, ctx.Err()
+		case <-time.After(txWaitTimeBetweenAttempts):
+		}
+	}
+
+	return common.Hash{}, fmt.Errorf("User operation %s wasn't included by the bundler", opHash)
+}
+
+func (wallet *SmartWallet) call(ctx context.Context, to common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	data, err := wallet.abi.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := wallet.handler.GetProvider().CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.abi.Unpack(method, result)
+}
+
+func getUserOperationHash(op *UserOperation, entryPoint common.Address, chainId *big.Int) (common.Hash, error) {
+	addressType, _ := ethAbi.NewType("address", "", nil)
+	uint256Type, _ := ethAbi.NewType("uint256", "", nil)
+	bytes32Type, _ := ethAbi.NewType("bytes32", "", nil)
+
+	packedOp, err := ethAbi.Arguments{
+		{Type: addressType},
+		{Type: uint256Type},
+		{Type: bytes32Type},
+		{Type: bytes32Type},
+		{Type: uint256Type},
+		{Type: uint256Type},
+		{Type: uint256Type},
+		{Type: uint256Type},
+		{Type: uint256Type},
+		{Type: bytes32Type},
+	}.Pack(
+		op.Sender,
+		op.Nonce,
+		crypto.Keccak256Hash(op.InitCode),
+		crypto.Keccak256Hash(op.CallData),
+		op.CallGasLimit,
+		op.VerificationGasLimit,
+		op.PreVerificationGas,
+		op.MaxFeePerGas,
+		op.MaxPriorityFeePerGas,
+		crypto.Keccak256Hash(op.PaymasterAndData),
+	)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	encoded, err := ethAbi.Arguments{
+		{Type: bytes32Type},
+		{Type: addressType},
+		{Type: uint256Type},
+	}.Pack(crypto.Keccak256Hash(packedOp), entryPoint, chainId)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+func toUserOperationJSON(op *UserOperation) *userOperationJSON {
+	return &userOperationJSON{
+		Sender:               op.Sender,
+		Nonce:                (*hexutil.Big)(op.Nonce),
+		InitCode:             op.InitCode,
+		CallData:             op.CallData,
+		CallGasLimit:         (*hexutil.Big)(op.CallGasLimit),
+		VerificationGasLimit: (*hexutil.Big)(op.VerificationGasLimit),
+		PreVerificationGas:   (*hexutil.Big)(op.PreVerificationGas),
+		MaxFeePerGas:         (*hexutil.Big)(op.MaxFeePerGas),
+		MaxPriorityFeePerGas: (*hexutil.Big)(op.MaxPriorityFeePerGas),
+		PaymasterAndData:     op.PaymasterAndData,
+		Signature:            op.Signature,
+	}
+}
@@ -0,0 +1,58 @@
+package thirdweb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateUserOperationGas(t *testing.T) {
+	var sentOp userOperationJSON
+	bundler := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Id     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "eth_estimateUserOperationGas", req.Method)
+		assert.Nil(t, json.Unmarshal(req.Params[0], &sentOp))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":{"preVerificationGas":"0x1","verificationGasLimit":"0x2","callGasLimit":"0x3"}}`, req.Id)))
+	}))
+	defer bundler.Close()
+
+	handler, err := NewProviderHandler(nil, "")
+	assert.Nil(t, err)
+	wallet, err := newSmartWallet(handler, &SmartWalletOptions{
+		FactoryAddress:    "0x0000000000000000000000000000000000000001",
+		EntryPointAddress: "0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789",
+		BundlerUrl:        bundler.URL,
+	})
+	assert.Nil(t, err)
+
+	gas, err := wallet.EstimateUserOperationGas(context.Background(), &UserOperation{
+		Sender:               common.HexToAddress("0x0000000000000000000000000000000000000002"),
+		Nonce:                big.NewInt(0),
+		CallGasLimit:         big.NewInt(0),
+		VerificationGasLimit: big.NewInt(0),
+		PreVerificationGas:   big.NewInt(0),
+		MaxFeePerGas:         big.NewInt(0),
+		MaxPriorityFeePerGas: big.NewInt(0),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1), gas.PreVerificationGas)
+	assert.Equal(t, big.NewInt(2), gas.VerificationGasLimit)
+	assert.Equal(t, big.NewInt(3), gas.CallGasLimit)
+
+	// Operations without a signature are estimated with the dummy one
+	assert.Equal(t, 65, len(sentOp.Signature))
+}
@@ -1,12 +1,17 @@
 package thirdweb
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/cbergoon/merkletree"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -116,3 +121,172 @@ func createSnapshot(
 		SnapshotUri: uri,
 	}, nil
 }
+
+// GenerateSnapshot
+//
+// # Build the merkle tree of an allowlist, with the proof of every entry
+//
+// The tree is built like merkletreejs with sorted leaves and pairs, which is what the drop
+// contracts verify claims against. MaxClaimable is a number of NFTs. A Price can only be set for
+// the native token, since the decimals of other currencies would need an RPC call.
+//
+// allowlist: the addresses that can claim, with how much each of them can claim
+//
+// returns: the merkle root to set in the claim condition, and the entries with their proofs
+//
+// Example
+//
+//	snapshot, err := thirdweb.GenerateSnapshot([]*thirdweb.SnapshotEntry{
+//		{Address: "{{wallet_address}}", MaxClaimable: "5"},
+//	})
+//	merkleRoot := hex.EncodeToString(snapshot.MerkleRoot[:])
+func GenerateSnapshot(allowlist []*SnapshotEntry) (*Snapshot, error) {
+	if len(allowlist) == 0 {
+		return nil, fmt.Errorf("Snapshot requires at least one entry")
+	}
+
+	leaves := [][]byte{}
+	seen := map[string]bool{}
+	for _, entry := range allowlist {
+		if !common.IsHexAddress(entry.Address) {
+			return nil, fmt.Errorf("Snapshot entry '%s' is not a valid address: %w", entry.Address, ErrInvalidAddress)
+		}
+
+		address := strings.ToLower(entry.Address)
+		if seen[address] {
+			return nil, fmt.Errorf("DUPLICATE_LEAFS: Address %s is duplicated in snapshot", entry.Address)
+		}
+		seen[address] = true
+
+		hasPrice := entry.Price != "" && entry.Price != "unlimited"
+		if hasPrice && entry.CurrencyAddress != "" && !isNativeToken(entry.CurrencyAddress) {
+			return nil, fmt.Errorf("Snapshot entry '%s' has a price in an ERC20 currency, which isn't supported", entry.Address)
+		}
+
+		leaf, err := hashSnapshotEntry(entry, 0, snapshotCurrencyDecimals(entry))
+		if err != nil {
+			return nil, err
+		}
+		leaves = append(leaves, leaf)
+	}
+
+	layers := buildMerkleLayers(leaves)
+	snapshot := &Snapshot{}
+	copy(snapshot.MerkleRoot[:], layers[len(layers)-1][0])
+
+	for i, entry := range allowlist {
+		withProof := &SnapshotEntryWithProof{
+			Address:         entry.Address,
+			MaxClaimable:    entry.MaxClaimable,
+			Price:           entry.Price,
+			CurrencyAddress: entry.CurrencyAddress,
+			Proof:           getMerkleProof(layers, leaves[i]),
+		}
+		withProof.fillDefaults()
+		snapshot.Entries = append(snapshot.Entries, withProof)
+	}
+
+	return snapshot, nil
+}
+
+// VerifySnapshotProof
+//
+// # Check that an allowlist entry is part of a snapshot, without any RPC call
+//
+// This is the same check the drop contracts make when claiming, so it can gate claims server side
+// before sending them. Entries are hashed like GenerateSnapshot does.
+//
+// root: the merkle root of the snapshot
+//
+// entry: the allowlist entry to check
+//
+// proof: the proof of the entry
+//
+// returns: true if the proof shows the entry is in the snapshot
+//
+// Example
+//
+//	entry := &thirdweb.SnapshotEntry{Address: "{{wallet_address}}", MaxClaimable: "5"}
+//	if !thirdweb.VerifySnapshotProof(snapshot.MerkleRoot, entry, proof) {
+//		return fmt.Errorf("Not on the allowlist")
+//	}
+func VerifySnapshotProof(root [32]byte, entry *SnapshotEntry, proof [][32]byte) bool {
+	if entry == nil || !common.IsHexAddress(entry.Address) {
+		return false
+	}
+
+	hash, err := hashSnapshotEntry(entry, 0, snapshotCurrencyDecimals(entry))
+	if err != nil {
+		return false
+	}
+
+	for _, node := range proof {
+		hash = hashMerklePair(hash, node[:])
+	}
+
+	return bytes.Equal(hash, root[:])
+}
+
+// Prices in the native token have 18 decimals, and without a price the decimals are unused
+func snapshotCurrencyDecimals(entry *SnapshotEntry) int {
+	if entry.Price == "" || entry.Price == "unlimited" {
+		return 0
+	}
+	return 18
+}
+
+// Builds every layer of the tree from the leaves up to the root. Leaves are sorted, each pair is
+// sorted before being hashed, and an odd node is carried up to the next layer as is.
+func buildMerkleLayers(leaves [][]byte) [][][]byte {
+	layer := make([][]byte, len(leaves))
+	copy(layer, leaves)
+	sort.Slice(layer, func(i, j int) bool {
+		return bytes.Compare(layer[i], layer[j]) < 0
+	})
+
+	layers := [][][]byte{layer}
+	for len(layer) > 1 {
+		next := [][]byte{}
+		for i := 0; i < len(layer); i += 2 {
+			if i+1 == len(layer) {
+				next = append(next, layer[i])
+			} else {
+				next = append(next, hashMerklePair(layer[i], layer[i+1]))
+			}
+		}
+		layers = append(layers, next)
+		layer = next
+	}
+
+	return layers
+}
+
+func getMerkleProof(layers [][][]byte, leaf []byte) [][32]byte {
+	index := 0
+	for i, node := range layers[0] {
+		if bytes.Equal(node, leaf) {
+			index = i
+			break
+		}
+	}
+
+	proof := [][32]byte{}
+	for _, layer := range layers[:len(layers)-1] {
+		sibling := index ^ 1
+		if sibling < len(layer) {
+			var node [32]byte
+			copy(node[:], layer[sibling])
+			proof = append(proof, node)
+		}
+		index /= 2
+	}
+
+	return proof
+}
+
+func hashMerklePair(a []byte, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256(a, b)
+}
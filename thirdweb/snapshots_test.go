@@ -0,0 +1,62 @@
+package thirdweb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateSnapshot(t *testing.T) {
+	allowlist := []*SnapshotEntry{
+		{Address: "0x0000000000000000000000000000000000000001", MaxClaimable: "1"},
+		{Address: "0x0000000000000000000000000000000000000002", MaxClaimable: "2"},
+		{Address: "0x0000000000000000000000000000000000000003", MaxClaimable: "3", Price: "0.1"},
+	}
+
+	snapshot, err := GenerateSnapshot(allowlist)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(snapshot.Entries))
+
+	for i, entry := range snapshot.Entries {
+		assert.Equal(t, allowlist[i].Address, entry.Address)
+		assert.Equal(t, zeroAddress, entry.CurrencyAddress)
+
+		assert.True(t, VerifySnapshotProof(snapshot.MerkleRoot, allowlist[i], entry.Proof))
+	}
+}
+
+func TestVerifySnapshotProofRejectsWrongEntries(t *testing.T) {
+	allowlist := []*SnapshotEntry{
+		{Address: "0x0000000000000000000000000000000000000001", MaxClaimable: "1"},
+		{Address: "0x0000000000000000000000000000000000000002", MaxClaimable: "2"},
+	}
+	snapshot, err := GenerateSnapshot(allowlist)
+	assert.Nil(t, err)
+
+	proof := snapshot.Entries[0].Proof
+	assert.True(t, VerifySnapshotProof(snapshot.MerkleRoot, allowlist[0], proof))
+
+	// More than the allowed quantity
+	assert.False(t, VerifySnapshotProof(snapshot.MerkleRoot, &SnapshotEntry{Address: allowlist[0].Address, MaxClaimable: "5"}, proof))
+	// Address that's not on the allowlist
+	assert.False(t, VerifySnapshotProof(snapshot.MerkleRoot, &SnapshotEntry{Address: "0x0000000000000000000000000000000000000003", MaxClaimable: "1"}, proof))
+	// Proof of another entry
+	assert.False(t, VerifySnapshotProof(snapshot.MerkleRoot, allowlist[0], snapshot.Entries[1].Proof))
+	assert.False(t, VerifySnapshotProof(snapshot.MerkleRoot, nil, proof))
+}
+
+func TestGenerateSnapshotRejectsInvalidEntries(t *testing.T) {
+	_, err := GenerateSnapshot([]*SnapshotEntry{})
+	assert.NotNil(t, err)
+
+	_, err = GenerateSnapshot([]*SnapshotEntry{
+		{Address: "0x0000000000000000000000000000000000000001", MaxClaimable: "1"},
+		{Address: "0x0000000000000000000000000000000000000001", MaxClaimable: "2"},
+	})
+	assert.NotNil(t, err)
+
+	_, err = GenerateSnapshot([]*SnapshotEntry{
+		{Address: "0x0000000000000000000000000000000000000001", MaxClaimable: "1", Price: "1", CurrencyAddress: "0x0000000000000000000000000000000000000009"},
+	})
+	assert.NotNil(t, err)
+}
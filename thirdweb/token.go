@@ -2,11 +2,11 @@ package thirdweb
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 
 	"github.com/thirdweb-dev/go-sdk/v2/abi"
 )
@@ -32,13 +32,15 @@ type Token struct {
 	Events  *ContractEvents
 }
 
-func newToken(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*Token, error) {
-	if contractAbi, err := abi.NewTokenERC20(address, provider); err != nil {
+func newToken(handler *ProviderHandler, address common.Address, storage storage) (*Token, error) {
+	backend := handler.getBackend()
+
+	if contractAbi, err := abi.NewTokenERC20(address, backend); err != nil {
 		return nil, err
-	} else if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+	} else if helper, err := newContractHelper(address, handler); err != nil {
 		return nil, err
 	} else {
-		if erc20, err := newERC20Standard(provider, address, privateKey, storage); err != nil {
+		if erc20, err := newERC20Standard(handler, address, storage); err != nil {
 			return nil, err
 		} else {
 			encoder, err := newContractEncoder(abi.TokenERC20ABI, helper)
@@ -76,6 +78,10 @@ func (token *Token) GetVoteBalance(ctx context.Context) (*CurrencyValue, error)
 //
 // returns: vote balance of the specified wallet
 func (token *Token) GetVoteBalanceOf(ctx context.Context, address string) (*CurrencyValue, error) {
+	if !token.Helper.isValidAddress(address) {
+		return nil, ErrInvalidAddress
+	}
+
 	votes, err := token.abi.GetVotes(&bind.CallOpts{Context: ctx}, common.HexToAddress(address))
 	if err != nil {
 		return nil, err
@@ -84,6 +90,30 @@ func (token *Token) GetVoteBalanceOf(ctx context.Context, address string) (*Curr
 	return token.erc20.getValue(ctx, votes)
 }
 
+// Get the voting power of the specified wallet in this token at a past block.
+//
+// address: wallet address to check the vote balance of
+//
+// blockNumber: the block to check the vote balance at, which must already be mined
+//
+// returns: vote balance of the specified wallet at the given block
+//
+// Example
+//
+//	balance, err := contract.GetVoteBalanceAt(context.Background(), "{{wallet_address}}", big.NewInt(1000))
+func (token *Token) GetVoteBalanceAt(ctx context.Context, address string, blockNumber *big.Int) (*CurrencyValue, error) {
+	if !token.Helper.isValidAddress(address) {
+		return nil, ErrInvalidAddress
+	}
+
+	votes, err := token.abi.GetPastVotes(&bind.CallOpts{Context: ctx}, common.HexToAddress(address), blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return token.erc20.getValue(ctx, votes)
+}
+
 // Get the connected wallets delegatee address for this token.
 //
 // returns: delegation address of the connected wallet
@@ -95,6 +125,10 @@ func (token *Token) GetDelegation(ctx context.Context) (string, error) {
 //
 // returns: delegation address of the connected wallet
 func (token *Token) GetDelegationOf(ctx context.Context, address string) (string, error) {
+	if !token.Helper.isValidAddress(address) {
+		return "", ErrInvalidAddress
+	}
+
 	delegation, err := token.abi.Delegates(&bind.CallOpts{Context: ctx}, common.HexToAddress(address))
 	if err != nil {
 		return "", err
@@ -108,8 +142,17 @@ func (token *Token) GetDelegationOf(ctx context.Context, address string) (string
 // amount: amount of tokens to mint
 //
 // returns: transaction receipt of the mint
-func (token *Token) Mint(ctx context.Context, amount float64) (*types.Transaction, error) {
-	return token.erc20.Mint(ctx, amount)
+func (token *Token) Mint(ctx context.Context, amount float64, options ...*TransactionOptions) (*types.Transaction, error) {
+	return token.erc20.Mint(ctx, amount, options...)
+}
+
+// Check if a wallet is allowed to mint tokens.
+//
+// address: wallet address to check the minter role of
+//
+// returns: true if the wallet has the minter role on the contract
+func (token *Token) CanMint(ctx context.Context, address string) (bool, error) {
+	return token.erc20.CanMint(ctx, address)
 }
 
 // Mint tokens to a specified wallet.
@@ -123,8 +166,8 @@ func (token *Token) Mint(ctx context.Context, amount float64) (*types.Transactio
 // Example
 //
 //	tx, err := contract.MintTo(context.Background(), "{{wallet_address}}", 1)
-func (token *Token) MintTo(ctx context.Context, to string, amount float64) (*types.Transaction, error) {
-	return token.erc20.MintTo(ctx, to, amount)
+func (token *Token) MintTo(ctx context.Context, to string, amount float64, options ...*TransactionOptions) (*types.Transaction, error) {
+	return token.erc20.MintTo(ctx, to, amount, options...)
 }
 
 // Mint tokens to a list of wallets.
@@ -147,8 +190,8 @@ func (token *Token) MintTo(ctx context.Context, to string, amount float64) (*typ
 //	}
 //
 //	tx, err := contract.MintBatchTo(context.Background(), args)
-func (token *Token) MintBatchTo(ctx context.Context, args []*TokenAmount) (*types.Transaction, error) {
-	return token.erc20.MintBatchTo(ctx, args)
+func (token *Token) MintBatchTo(ctx context.Context, args []*TokenAmount, options ...*TransactionOptions) (*types.Transaction, error) {
+	return token.erc20.MintBatchTo(ctx, args, options...)
 }
 
 // Delegate the connected wallets tokens to a specified wallet.
@@ -156,8 +199,12 @@ func (token *Token) MintBatchTo(ctx context.Context, args []*TokenAmount) (*type
 // delegateeAddress: wallet address to delegate tokens to
 //
 // returns: transaction receipt of the delegation
-func (token *Token) DelegateTo(ctx context.Context, delegatreeAddress string) (*types.Transaction, error) {
-	txOpts, err := token.Helper.GetTxOptions(ctx)
+func (token *Token) DelegateTo(ctx context.Context, delegatreeAddress string, options ...*TransactionOptions) (*types.Transaction, error) {
+	if !token.Helper.isValidAddress(delegatreeAddress) {
+		return nil, ErrInvalidAddress
+	}
+
+	txOpts, err := token.Helper.GetTxOptions(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -87,3 +88,27 @@ func TestTransferToken(t *testing.T) {
 	balance, _ = token.Balance(context.Background())
 	assert.Equal(t, float64(0), balance.DisplayValue)
 }
+
+func TestTokenRejectsUnchecksummedAddresses(t *testing.T) {
+	handler, err := NewProviderHandler(nil, "")
+	assert.Nil(t, err)
+	handler.UpdateStrictAddressChecksums(true)
+
+	token, err := newToken(handler, common.HexToAddress("0x0000000000000000000000000000000000000001"), nil)
+	assert.Nil(t, err)
+
+	// The checksummed form is 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed
+	lowercase := "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+
+	_, err = token.BalanceOf(context.Background(), lowercase)
+	assert.ErrorIs(t, err, ErrInvalidAddress)
+
+	_, err = token.Transfer(context.Background(), lowercase, 1)
+	assert.ErrorIs(t, err, ErrInvalidAddress)
+
+	_, err = token.MintBatchTo(context.Background(), []*TokenAmount{{ToAddress: lowercase, Amount: 1}})
+	assert.ErrorIs(t, err, ErrInvalidAddress)
+
+	_, err = token.DelegateTo(context.Background(), lowercase)
+	assert.ErrorIs(t, err, ErrInvalidAddress)
+}
@@ -0,0 +1,48 @@
+package thirdweb
+
+import (
+	"context"
+)
+
+// A tracer receives a span for every eth_call and eth_sendRawTransaction the SDK makes, so they
+// show up in your traces. Use NewOpenTelemetryTracer to record them with OpenTelemetry, or implement
+// this interface to wrap the tracer of another library.
+type Tracer interface {
+	StartSpan(ctx context.Context, name string) (context.Context, Span)
+}
+
+type Span interface {
+	SetAttribute(key string, value string)
+	RecordError(err error)
+	End()
+}
+
+type noopSpan struct{}
+
+func (span noopSpan) SetAttribute(key string, value string) {}
+
+func (span noopSpan) RecordError(err error) {}
+
+func (span noopSpan) End() {}
+
+// Starts a span for an RPC call, tagged with the RPC method and the chain ID
+func (handler *ProviderHandler) startSpan(ctx context.Context, method string) (context.Context, Span) {
+	if handler.tracer == nil {
+		return ctx, noopSpan{}
+	}
+
+	ctx, span := handler.tracer.StartSpan(ctx, method)
+	span.SetAttribute("rpc.method", method)
+	if handler.tracedChainId != "" {
+		span.SetAttribute("eth.chain_id", handler.tracedChainId)
+	}
+
+	return ctx, span
+}
+
+func endSpan(span Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+}
@@ -0,0 +1,52 @@
+package thirdweb
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type otelTracer struct {
+	tracer trace.Tracer
+}
+
+type otelSpan struct {
+	span trace.Span
+}
+
+// NewOpenTelemetryTracer
+//
+// # Wrap an OpenTelemetry tracer to record the RPC calls of the SDK
+//
+// tracer: the OpenTelemetry tracer to start the spans with
+//
+// returns: a tracer to set as the Tracer of the SDK options
+//
+// Example
+//
+//	sdk, err := thirdweb.NewThirdwebSDK("mumbai", &thirdweb.SDKOptions{
+//		Tracer: thirdweb.NewOpenTelemetryTracer(otel.Tracer("my-app")),
+//	})
+func NewOpenTelemetryTracer(tracer trace.Tracer) Tracer {
+	return &otelTracer{tracer}
+}
+
+func (t *otelTracer) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	ctx, span := t.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+	return ctx, &otelSpan{span}
+}
+
+func (s *otelSpan) SetAttribute(key string, value string) {
+	s.span.SetAttributes(attribute.String(key, value))
+}
+
+func (s *otelSpan) RecordError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+func (s *otelSpan) End() {
+	s.span.End()
+}
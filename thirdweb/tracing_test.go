@@ -0,0 +1,85 @@
+package thirdweb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type mockSpan struct {
+	name       string
+	attributes map[string]string
+	ended      bool
+}
+
+func (span *mockSpan) SetAttribute(key string, value string) {
+	span.attributes[key] = value
+}
+
+func (span *mockSpan) RecordError(err error) {}
+
+func (span *mockSpan) End() {
+	span.ended = true
+}
+
+type mockTracer struct {
+	spans []*mockSpan
+}
+
+func (tracer *mockTracer) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	span := &mockSpan{name: name, attributes: map[string]string{}}
+	tracer.spans = append(tracer.spans, span)
+	return ctx, span
+}
+
+func TestSendTransactionSpan(t *testing.T) {
+	handler, err := NewProviderHandler(nil, "")
+	assert.Nil(t, err)
+
+	tracer := &mockTracer{}
+	handler.tracer = tracer
+	handler.tracedChainId = "1337"
+	handler.UpdateBroadcaster(&mockBroadcaster{})
+
+	to := common.HexToAddress("0x1234")
+	tx := types.NewTx(&types.LegacyTx{To: &to, Gas: 21000})
+	err = handler.getBackend().SendTransaction(context.Background(), tx)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 1, len(tracer.spans))
+	span := tracer.spans[0]
+	assert.True(t, span.ended)
+	assert.Equal(t, "eth_sendRawTransaction", span.attributes["rpc.method"])
+	assert.Equal(t, "1337", span.attributes["eth.chain_id"])
+	assert.Equal(t, to.Hex(), span.attributes["eth.contract_address"])
+	assert.Equal(t, tx.Hash().Hex(), span.attributes["eth.tx_hash"])
+}
+
+func TestOpenTelemetryTracer(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	handler, err := NewProviderHandler(nil, "")
+	assert.Nil(t, err)
+
+	handler.tracer = NewOpenTelemetryTracer(provider.Tracer("thirdweb"))
+	handler.UpdateBroadcaster(&mockRelayer{})
+
+	to := common.HexToAddress("0x1234")
+	tx := types.NewTx(&types.LegacyTx{To: &to, Gas: 21000})
+	err = handler.getBackend().SendTransaction(context.Background(), tx)
+	assert.Nil(t, err)
+
+	spans := recorder.Ended()
+	assert.Equal(t, 1, len(spans))
+	assert.Equal(t, "eth_sendRawTransaction", spans[0].Name())
+	assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+	assert.Contains(t, spans[0].Attributes(), attribute.String("eth.contract_address", to.Hex()))
+}
@@ -0,0 +1,90 @@
+package thirdweb
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Watches a transaction in the background until it's mined, see WatchTransaction
+type TransactionWatcher struct {
+	hash    common.Hash
+	helper  *contractHelper
+	done    chan struct{}
+	mu      sync.Mutex
+	receipt *types.Receipt
+	err     error
+}
+
+func newTransactionWatcher(hash common.Hash, helper *contractHelper) *TransactionWatcher {
+	return &TransactionWatcher{
+		hash:   hash,
+		helper: helper,
+		done:   make(chan struct{}),
+	}
+}
+
+// Done is closed once the transaction is mined, or watching it failed
+func (watcher *TransactionWatcher) Done() <-chan struct{} {
+	return watcher.done
+}
+
+// Receipt returns the receipt of the mined transaction, or nil until Done is closed
+func (watcher *TransactionWatcher) Receipt() *types.Receipt {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+	return watcher.receipt
+}
+
+// Err returns why watching the transaction failed, or nil if it hasn't
+func (watcher *TransactionWatcher) Err() error {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+	return watcher.err
+}
+
+func (watcher *TransactionWatcher) watch(ctx context.Context) {
+	defer close(watcher.done)
+
+	provider := watcher.helper.GetProvider()
+	for {
+		receipt, err := provider.TransactionReceipt(ctx, watcher.hash)
+		if err == nil {
+			confirmed := true
+			if watcher.helper.blockConfirmations > 0 {
+				confirmed, err = watcher.helper.waitForConfirmations(ctx, receipt)
+			}
+
+			if err != nil {
+				watcher.finish(nil, err)
+				return
+			}
+			if confirmed {
+				watcher.finish(receipt, nil)
+				return
+			}
+		} else if !errors.Is(err, ethereum.NotFound) {
+			watcher.finish(nil, err)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			watcher.finish(nil, ctx.Err())
+			return
+		case <-time.After(txWaitTimeBetweenAttempts):
+		}
+	}
+}
+
+func (watcher *TransactionWatcher) finish(receipt *types.Receipt, err error) {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+	watcher.receipt = receipt
+	watcher.err = err
+}
@@ -0,0 +1,166 @@
+package thirdweb
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	signerTypes "github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// Encodes Go structs as EIP-712 typed data for a specific domain, so they can be hashed and signed.
+//
+// The fields of a message struct are described with tags like `eip712:"name,type"`, where name is
+// the name of the field in the EIP-712 type and type is its Solidity type. The fields are encoded
+// in the order they are declared, and fields without a tag are skipped. Addresses can be strings
+// or common.Address, integers can be Go integers, *big.Int or decimal strings, and fixed size
+// bytes can be byte arrays or slices. Nested structs aren't supported.
+type TypedDataEncoder struct {
+	domain signerTypes.TypedDataDomain
+}
+
+// NewTypedDataEncoder
+//
+// # Create an encoder for the typed data of an EIP-712 domain
+//
+// name: the name of the signing domain, usually the name of the contract
+//
+// version: the version of the signing domain
+//
+// chainId: the ID of the chain the signatures are valid on
+//
+// verifyingContract: the address of the contract that verifies the signatures
+//
+// Example
+//
+//	type Greeting struct {
+//		From    string   `eip712:"from,address"`
+//		Message string   `eip712:"message,string"`
+//		Nonce   *big.Int `eip712:"nonce,uint256"`
+//	}
+//
+//	encoder := thirdweb.NewTypedDataEncoder("Greeter", "1", big.NewInt(1), "{{contract_address}}")
+//	digest, err := encoder.Hash("Greeting", &Greeting{From: "{{wallet_address}}", Message: "gm", Nonce: big.NewInt(0)})
+func NewTypedDataEncoder(name string, version string, chainId *big.Int, verifyingContract string) *TypedDataEncoder {
+	return &TypedDataEncoder{
+		domain: signerTypes.TypedDataDomain{
+			Name:              name,
+			Version:           version,
+			ChainId:           (*math.HexOrDecimal256)(new(big.Int).Set(chainId)),
+			VerifyingContract: verifyingContract,
+		},
+	}
+}
+
+// Hash typed data
+//
+// primaryType: the name of the EIP-712 type of the message
+//
+// message: the struct to hash, or a pointer to it
+//
+// returns: the keccak256 digest of the typed data, ready to be signed
+func (encoder *TypedDataEncoder) Hash(primaryType string, message interface{}) ([]byte, error) {
+	typedData, err := encoder.TypedData(primaryType, message)
+	if err != nil {
+		return nil, err
+	}
+
+	return hashTypedData(typedData)
+}
+
+// Get the typed data of a message
+//
+// primaryType: the name of the EIP-712 type of the message
+//
+// message: the struct to encode, or a pointer to it
+//
+// returns: the typed data of the message in the domain of the encoder
+func (encoder *TypedDataEncoder) TypedData(primaryType string, message interface{}) (signerTypes.TypedData, error) {
+	value := reflect.ValueOf(message)
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return signerTypes.TypedData{}, fmt.Errorf("Typed data message should be a struct, but type '%v' was provided", reflect.TypeOf(message))
+	}
+
+	fields := []signerTypes.Type{}
+	data := signerTypes.TypedDataMessage{}
+	for i := 0; i < value.NumField(); i++ {
+		tag, ok := value.Type().Field(i).Tag.Lookup("eip712")
+		if !ok {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		if len(parts) != 2 {
+			return signerTypes.TypedData{}, fmt.Errorf("Field %s should have a tag like `eip712:\"name,type\"`", value.Type().Field(i).Name)
+		}
+		name, fieldType := parts[0], parts[1]
+
+		fieldValue, err := typedDataValue(fieldType, value.Field(i))
+		if err != nil {
+			return signerTypes.TypedData{}, fmt.Errorf("Field %s %w", value.Type().Field(i).Name, err)
+		}
+
+		fields = append(fields, signerTypes.Type{Name: name, Type: fieldType})
+		data[name] = fieldValue
+	}
+
+	return signerTypes.TypedData{
+		Types: signerTypes.Types{
+			primaryType: fields,
+			"EIP712Domain": []signerTypes.Type{
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+		},
+		PrimaryType: primaryType,
+		Domain:      encoder.domain,
+		Message:     data,
+	}, nil
+}
+
+// Converts a field to the value the go-ethereum typed data hasher expects for its type
+func typedDataValue(fieldType string, value reflect.Value) (interface{}, error) {
+	switch {
+	case fieldType == "address":
+		switch address := value.Interface().(type) {
+		case common.Address:
+			return address.Hex(), nil
+		case string:
+			return address, nil
+		}
+	case strings.HasPrefix(fieldType, "uint") || strings.HasPrefix(fieldType, "int"):
+		switch value.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return strconv.FormatInt(value.Int(), 10), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return strconv.FormatUint(value.Uint(), 10), nil
+		case reflect.String:
+			return value.String(), nil
+		}
+		if number, ok := value.Interface().(*big.Int); ok && number != nil {
+			return number.String(), nil
+		}
+	case strings.HasPrefix(fieldType, "bytes"):
+		if value.Kind() == reflect.Array && value.Type().Elem().Kind() == reflect.Uint8 {
+			bytes := make([]byte, value.Len())
+			reflect.Copy(reflect.ValueOf(bytes), value)
+			return bytes, nil
+		}
+		if bytes, ok := value.Interface().([]byte); ok {
+			return bytes, nil
+		}
+	case fieldType == "string" || fieldType == "bool":
+		return value.Interface(), nil
+	}
+
+	return nil, fmt.Errorf("of type '%v' can't be encoded as '%s'", value.Type(), fieldType)
+}
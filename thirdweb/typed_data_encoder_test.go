@@ -0,0 +1,82 @@
+package thirdweb
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	signerTypes "github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+)
+
+type testGreeting struct {
+	From    common.Address `eip712:"from,address"`
+	Message string         `eip712:"message,string"`
+	Nonce   *big.Int       `eip712:"nonce,uint256"`
+	Expiry  int            `eip712:"expiry,uint128"`
+	Id      [32]byte       `eip712:"id,bytes32"`
+	Ignored string
+}
+
+func TestTypedDataEncoderHash(t *testing.T) {
+	contractAddress := "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"
+	from := common.HexToAddress("0x71C7656EC7ab88b098defB751B7401B5f6d8976F")
+	id := [32]byte{1, 2, 3}
+
+	encoder := NewTypedDataEncoder("Greeter", "1", big.NewInt(137), contractAddress)
+	digest, err := encoder.Hash("Greeting", &testGreeting{From: from, Message: "gm", Nonce: big.NewInt(7), Expiry: 100, Id: id, Ignored: "x"})
+	assert.Nil(t, err)
+
+	// The same typed data written out by hand
+	expected, err := hashTypedData(signerTypes.TypedData{
+		Types: signerTypes.Types{
+			"Greeting": []signerTypes.Type{
+				{Name: "from", Type: "address"},
+				{Name: "message", Type: "string"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "expiry", Type: "uint128"},
+				{Name: "id", Type: "bytes32"},
+			},
+			"EIP712Domain": []signerTypes.Type{
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+		},
+		PrimaryType: "Greeting",
+		Domain: signerTypes.TypedDataDomain{
+			Name:              "Greeter",
+			Version:           "1",
+			ChainId:           math.NewHexOrDecimal256(137),
+			VerifyingContract: contractAddress,
+		},
+		Message: signerTypes.TypedDataMessage{
+			"from":    from.Hex(),
+			"message": "gm",
+			"nonce":   "7",
+			"expiry":  "100",
+			"id":      id[:],
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, digest)
+}
+
+func TestTypedDataEncoderRejectsInvalidMessages(t *testing.T) {
+	encoder := NewTypedDataEncoder("Greeter", "1", big.NewInt(1), "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+
+	_, err := encoder.Hash("Greeting", "not a struct")
+	assert.NotNil(t, err)
+
+	_, err = encoder.Hash("Greeting", &struct {
+		Nonce int `eip712:"nonce"`
+	}{})
+	assert.NotNil(t, err)
+
+	_, err = encoder.Hash("Greeting", &struct {
+		Nonce bool `eip712:"nonce,uint256"`
+	}{})
+	assert.NotNil(t, err)
+}
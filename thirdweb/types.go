@@ -1,8 +1,11 @@
 package thirdweb
 
 import (
+	"encoding/json"
+	"fmt"
 	"math/big"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -12,7 +15,123 @@ import (
 type SDKOptions struct {
 	PrivateKey string
 	GatewayUrl string
-	HttpClient *http.Client
+	// IPFS gateways tried in order when fetching from GatewayUrl fails, each attempt emits
+	// EventGatewayFallback
+	FallbackGatewayUrls []string
+	HttpClient          *http.Client
+	// Multiplier applied to the estimated gas limit of every transaction, defaults to 1.2
+	GasLimitMultiplier float64
+	// Number of blocks to wait for after a transaction is mined before returning, defaults to 0
+	BlockConfirmations int
+	// Runs write transactions with eth_call instead of broadcasting them, see UpdateSimulationMode.
+	// Methods that read the receipt of their transaction, like deploying a contract, will fail.
+	SimulationMode bool
+	// Enables the wallet lookups backed by the Alchemy enhanced APIs
+	AlchemyApiKey string
+	// Lets GetContract fall back to the verified ABI on Etherscan for contracts not deployed with thirdweb
+	EtherscanApiKey string
+	// URL and access token of a thirdweb Engine instance, which enables webhooks and sends every
+	// transaction through Engine unless a gasless relayer is set
+	EngineUrl         string
+	EngineAccessToken string
+	// Engine backend wallet that sends the transactions queued through Engine
+	EngineBackendWallet string
+	// Records a span for every eth_call and eth_sendRawTransaction, see NewOpenTelemetryTracer
+	Tracer Tracer
+	// Records RPC, transaction and IPFS durations and errors, see NewPrometheusMetrics
+	Metrics Metrics
+	// Sends all write transactions as ERC-4337 user operations from a smart wallet, see SmartWallet
+	SmartWallet *SmartWalletOptions
+	// Relays all write transactions through Biconomy, OpenGSN or OpenZeppelin Defender so the wallet doesn't pay for gas.
+	// The contracts need to trust the Biconomy forwarder, which thirdweb contracts do by default.
+	Gasless *GaslessOptions
+	// Spreads RPC calls over several clients and nodes for high-throughput applications
+	ConnectionPool *ConnectionPoolOptions
+	// Operator addresses checked by GetApprovedOperators, defaults to the OpenSea, LooksRare and
+	// Blur operators on mainnet
+	KnownOperators []string
+	// Rejects addresses that don't have a valid EIP-55 checksum, including all lowercase addresses,
+	// wherever the SDK validates an address argument. Defaults to false.
+	StrictAddressChecksums bool
+	// Longest wait between attempts to resubscribe in ListenForContractEvents, defaults to a minute
+	EventReconnectMaxBackoff time.Duration
+	// Longest time the metadata of a single NFT can take to fetch when listing many NFTs, like in
+	// GetAll, defaults to 10 seconds. The listing fails with an ErrGatewayTimeout when it's hit.
+	MetadataFetchTimeout time.Duration
+	// Longest time a GetOwned call shared by concurrent callers for the same address can take,
+	// defaults to 2 minutes. The shared call keeps running when one of its callers cancels.
+	SharedRequestTimeout time.Duration
+}
+
+type ConnectionPoolOptions struct {
+	// Number of clients in the pool, at least one per URL
+	Size int
+	// RPC URLs the clients connect to in turn, defaults to the RPC URL of the SDK. HTTP nodes that
+	// return errors are skipped for 30 seconds. Failures are detected at the HTTP transport, so
+	// WebSocket nodes are never skipped and keep getting their share of the calls.
+	Urls []string
+}
+
+type GaslessOptions struct {
+	Biconomy     *BiconomyOptions
+	OpenGSN      *OpenGSNOptions
+	OpenZeppelin *OpenZeppelinOptions
+}
+
+type BiconomyOptions struct {
+	ApiKey string
+	ApiId  string
+	// How long the signed request stays valid for, defaults to an hour
+	DeadlineSeconds int
+}
+
+type SmartWalletOptions struct {
+	// Factory that deploys the smart wallet accounts
+	FactoryAddress    string
+	EntryPointAddress string
+	// RPC URL of an ERC-4337 bundler
+	BundlerUrl string
+}
+
+// The contracts need to trust ForwarderAddress as their ERC-2771 forwarder
+type OpenGSNOptions struct {
+	RelayHubAddress   string
+	ForwarderAddress  string
+	PaymasterAddress  string
+	PreferredRelayUrl string
+	// Fees charged by the relay, which need to be at least what the relay is configured with
+	PctRelayFee  int
+	BaseRelayFee int64
+}
+
+// Relays through an OpenZeppelin Defender relayer, like the gasless.openzeppelin option of the
+// TypeScript SDK. The contracts need to trust RelayerForwarderAddress as their ERC-2771 forwarder.
+type OpenZeppelinOptions struct {
+	// URL that forwards the signed request to the Defender relayer, usually a Defender autotask webhook
+	RelayerUrl string
+	// Defaults to the forwarder that contracts deployed with the SDK trust
+	RelayerForwarderAddress string
+	// Credentials of the relayer, sent with every request as basic auth if set
+	RelayAPIKey    string
+	RelaySecretKey string
+}
+
+type GasPriceEstimate struct {
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	// How long a transaction paying these fees is expected to wait before being included
+	EstimatedWait time.Duration
+}
+
+// Per-call overrides for the transaction sent by a write method. Any field left unset keeps the
+// value the SDK would have computed. Setting GasPrice sends a legacy transaction.
+type TransactionOptions struct {
+	GasLimit             uint64
+	GasPrice             *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	Nonce                *big.Int
+	Value                *big.Int
 }
 
 type Metadata struct {
@@ -33,6 +152,100 @@ type NFTMetadata struct {
 	Attributes      interface{} `json:"attributes,omitempty"`
 }
 
+type nftMetadataAlias NFTMetadata
+
+// Token IDs are serialized as decimal strings since JSON numbers lose precision above 2^53
+func (metadata NFTMetadata) MarshalJSON() ([]byte, error) {
+	var id *string
+	if metadata.Id != nil {
+		decimal := metadata.Id.String()
+		id = &decimal
+	}
+
+	return json.Marshal(&struct {
+		Id *string `json:"id"`
+		nftMetadataAlias
+	}{
+		Id:               id,
+		nftMetadataAlias: nftMetadataAlias(metadata),
+	})
+}
+
+// Accepts token IDs as either decimal strings or JSON numbers
+func (metadata *NFTMetadata) UnmarshalJSON(data []byte) error {
+	aux := &struct {
+		Id json.RawMessage `json:"id"`
+		*nftMetadataAlias
+	}{
+		nftMetadataAlias: (*nftMetadataAlias)(metadata),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	if len(aux.Id) == 0 || string(aux.Id) == "null" {
+		return nil
+	}
+
+	id, ok := new(big.Int).SetString(strings.Trim(string(aux.Id), "\""), 10)
+	if !ok {
+		return fmt.Errorf("Invalid token id %s", string(aux.Id))
+	}
+	metadata.Id = id
+
+	return nil
+}
+
+// Copies the fields of the metadata, the nested properties and attributes are still shared
+func copyNFTMetadata(metadata *NFTMetadata) *NFTMetadata {
+	if metadata == nil {
+		return nil
+	}
+
+	copied := *metadata
+	if metadata.Id != nil {
+		copied.Id = new(big.Int).Set(metadata.Id)
+	}
+	return &copied
+}
+
+// A webhook registered with thirdweb Engine. Engine registers one webhook per event, so the ID
+// covers all of them and is only meant to be passed back to UnregisterWebhook.
+type WebhookRegistration struct {
+	Id     string
+	Url    string
+	Events []string
+	// Secret used by Engine to sign the webhook requests, one per event
+	Secrets map[string]string
+}
+
+type OwnedNFT struct {
+	ContractAddress string
+	TokenId         *big.Int
+	// ERC721 or ERC1155
+	TokenType string
+	Balance   int
+	Metadata  *NFTMetadata
+}
+
+type WalletBalance struct {
+	Native *CurrencyValue
+	Tokens []*WalletTokenBalance
+}
+
+type WalletTokenBalance struct {
+	ContractAddress string
+	Balance         *CurrencyValue
+}
+
+type DeploymentInfo struct {
+	BlockNumber uint64
+	Timestamp   time.Time
+	// Empty if the deployment transaction couldn't be identified in the block
+	DeployerAddress string
+	TransactionHash string
+}
+
 type NFTMetadataInput struct {
 	Name            string      `mapstructure:"name" json:"name"`
 	Description     string      `mapstructure:"description,omitempty" json:"description"`
@@ -108,16 +321,31 @@ type TokenAmount struct {
 }
 
 type WrappedToken struct {
-	address string
-	name    string
-	symbol  string
+	Address string
+	Name    string
+	Symbol  string
 }
 
 type NativeToken struct {
-	name     string
-	symbol   string
-	decimals int
-	wrapper  *WrappedToken
+	Name     string
+	Symbol   string
+	Decimals int
+	Wrapped  *WrappedToken
+}
+
+// Supply and holder counts of a collection, rebuilt from its transfer events
+type CollectionStats struct {
+	TotalMinted       *big.Int
+	TotalBurned       *big.Int
+	CirculatingSupply *big.Int
+	// Number of distinct wallets that currently hold at least one token
+	UniqueOwners int
+}
+
+// The royalty recipient and cut of secondary sales, in basis points
+type RoyaltyInfo struct {
+	Seller               string
+	SellerFeeBasisPoints int
 }
 
 type Signature721PayloadInput struct {
@@ -509,6 +737,16 @@ func (listing *NewDirectListing) fillDefaults() {
 	}
 }
 
+// Fields left at their zero value keep the current value of the listing
+type UpdateListingInput struct {
+	BuyoutPricePerToken float64
+	// Requires a new BuyoutPricePerToken, since the current price is in the current currency
+	CurrencyContractAddress  string
+	Quantity                 int
+	StartTimeInEpochSeconds  int
+	ListingDurationInSeconds int
+}
+
 type AuctionListing struct {
 	Id                                string
 	AssetContractAddress              string
@@ -525,6 +763,32 @@ type AuctionListing struct {
 	SellerAddress                     string
 }
 
+type NFTFilter struct {
+	// Only include NFTs owned by this address
+	Owner string
+	// Only include these token IDs, defaults to every token on the contract
+	TokenIds []int
+	// Only include NFTs with a matching attribute in their metadata
+	HasAttribute *AttributeFilter
+	// Maximum number of NFTs to return, 0 returns all matching NFTs
+	MaxResults int
+}
+
+type AttributeFilter struct {
+	TraitType string
+	// Matches any value of the trait if nil
+	Value interface{}
+}
+
+// A bid on an auction listing or an offer on a direct listing
+type OfferInfo struct {
+	BidderAddress   string
+	CurrencyAddress string
+	// Price offered per token, in the smallest unit of the currency
+	BidAmount              *big.Int
+	BidAmountCurrencyValue *CurrencyValue
+}
+
 type MarketplaceFilter struct {
 	Start         int
 	Count         int
@@ -595,7 +859,37 @@ type SnapshotEntry struct {
 	Price           string `json:"price"`
 	CurrencyAddress string `json:"currencyAddress"`
 }
+
+// A merkle tree of allowlist entries, and the proof each entry needs to claim
+type Snapshot struct {
+	MerkleRoot [32]byte
+	Entries    []*SnapshotEntryWithProof
+}
+
 type ShardData struct {
 	Proofs  []string        `json:"proofs"`
 	Entries []SnapshotEntry `json:"entries"`
 }
+
+type PublisherMetadata struct {
+	Name        string
+	Version     string
+	Description string
+	Readme      string
+	License     string
+	Tags        []string
+}
+
+type PublishedContract struct {
+	Id                    string
+	PublishTimestamp      time.Time
+	MetadataUri           string
+	BytecodeHash          string
+	ImplementationAddress string
+}
+
+type ValidationWarning struct {
+	// Path of the option in the config, like "gasless.openzeppelin"
+	Field   string
+	Message string
+}
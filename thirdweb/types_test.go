@@ -0,0 +1,43 @@
+package thirdweb
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNFTMetadataJSONRoundTrip(t *testing.T) {
+	// 2^53 + 1 can't be represented exactly as a float64
+	id, _ := new(big.Int).SetString("9007199254740993", 10)
+	metadata := &NFTMetadata{
+		Id:   id,
+		Uri:  "ipfs://QmHash/0",
+		Name: "NFT",
+	}
+
+	body, err := json.Marshal(metadata)
+	assert.Nil(t, err)
+	assert.Contains(t, string(body), `"id":"9007199254740993"`)
+
+	decoded := &NFTMetadata{}
+	err = json.Unmarshal(body, decoded)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, decoded.Id.Cmp(id))
+	assert.Equal(t, metadata.Uri, decoded.Uri)
+	assert.Equal(t, metadata.Name, decoded.Name)
+}
+
+func TestNFTMetadataUnmarshalNumericId(t *testing.T) {
+	decoded := &NFTMetadata{}
+	err := json.Unmarshal([]byte(`{"id": 5, "name": "NFT"}`), decoded)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), decoded.Id.Int64())
+
+	// A missing id leaves the existing one untouched
+	decoded = &NFTMetadata{Id: big.NewInt(7)}
+	err = json.Unmarshal([]byte(`{"name": "NFT"}`), decoded)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), decoded.Id.Int64())
+}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/google/uuid"
 )
 
@@ -44,13 +43,8 @@ type WalletAuthenticator struct {
 	*ProviderHandler
 }
 
-func newWalletAuthenticator(provider *ethclient.Client, privateKey string) (*WalletAuthenticator, error) {
-	handler, err := NewProviderHandler(provider, privateKey)
-	if err != nil {
-		return nil, err
-	}
-
-	return &WalletAuthenticator{handler}, nil
+func newWalletAuthenticator(handler *ProviderHandler) (*WalletAuthenticator, error) {
+	return &WalletAuthenticator{handler.clone()}, nil
 }
 
 // Client-side function that allows the connected wallet to login to a server-side application.
@@ -148,11 +142,10 @@ func (auth *WalletAuthenticator) Verify(
 
 	// If chain ID is specified, check that it matches the chain ID of the signature
 	if options != nil && options.ChainId != 0 && options.ChainId != payload.Payload.ChainId {
-		return "", fmt.Errorf(
-			"Chain ID '%d' does not match payload chain ID '%d'",
-			options.ChainId,
-			payload.Payload.ChainId,
-		)
+		return "", &ErrChainIDMismatch{
+			Expected: options.ChainId,
+			Got:      payload.Payload.ChainId,
+		}
 	}
 
 	decodedSignature, err := hexutil.Decode(payload.Signature)
@@ -410,7 +403,7 @@ func (auth *WalletAuthenticator) recoverAddress(message string, signature []byte
 
 func (auth *WalletAuthenticator) requireSigner() error {
 	if auth.GetPrivateKey() == nil {
-		return errors.New("This action requires a connected wallet. Please pass a valid private key to the SDK")
+		return ErrNoSigner
 	}
 
 	return nil
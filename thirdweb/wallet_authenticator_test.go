@@ -1,6 +1,7 @@
 package thirdweb
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -75,6 +76,11 @@ func TestRejectIncorrectChainId(t *testing.T) {
 	})
 
 	assert.Equal(t, err.Error(), "Chain ID '137' does not match payload chain ID '1'")
+
+	var mismatch *ErrChainIDMismatch
+	assert.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, 137, mismatch.Expected)
+	assert.Equal(t, 1, mismatch.Got)
 }
 
 func TestRejectIncorrectSigner(t *testing.T) {